@@ -0,0 +1,27 @@
+package maybe
+
+import "iter"
+
+// Collect returns Some with every value when all of ms are Some, otherwise None.
+func Collect[T any](ms []Maybe[T]) Maybe[[]T] {
+	values := make([]T, 0, len(ms))
+	for _, m := range ms {
+		if !m.valid {
+			return None[[]T]()
+		}
+		values = append(values, m.value)
+	}
+	return Some(values)
+}
+
+// CollectSeq is like Collect but stops consuming seq at the first None.
+func CollectSeq[T any](seq iter.Seq[Maybe[T]]) Maybe[[]T] {
+	values := []T{}
+	for m := range seq {
+		if !m.valid {
+			return None[[]T]()
+		}
+		values = append(values, m.value)
+	}
+	return Some(values)
+}
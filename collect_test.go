@@ -0,0 +1,49 @@
+package maybe_test
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/magicdrive/maybe"
+)
+
+func TestCollect(t *testing.T) {
+	all := maybe.Collect([]maybe.Maybe[int]{maybe.Some(1), maybe.Some(2)})
+	if !reflect.DeepEqual(all.UnwrapOr(nil), []int{1, 2}) {
+		t.Errorf("expected Some([1 2]), got %v", all)
+	}
+
+	missing := maybe.Collect([]maybe.Maybe[int]{maybe.Some(1), maybe.None[int]()})
+	if missing.IsSome() {
+		t.Errorf("expected None, got %v", missing)
+	}
+
+	empty := maybe.Collect[int](nil)
+	if !empty.IsSome() || len(empty.Unwrap()) != 0 {
+		t.Errorf("expected Some([]), got %v", empty)
+	}
+}
+
+func TestCollectSeqStopsAtFirstNone(t *testing.T) {
+	var pulled int
+	seq := func(yield func(maybe.Maybe[int]) bool) {
+		for _, m := range []maybe.Maybe[int]{maybe.Some(1), maybe.None[int](), maybe.Some(3)} {
+			pulled++
+			if !yield(m) {
+				return
+			}
+		}
+	}
+	if maybe.CollectSeq(seq).IsSome() {
+		t.Errorf("expected None")
+	}
+	if pulled != 2 {
+		t.Errorf("expected to stop after 2 elements, pulled %d", pulled)
+	}
+
+	got := maybe.CollectSeq(slices.Values([]maybe.Maybe[string]{maybe.Some("a")}))
+	if !reflect.DeepEqual(got.UnwrapOr(nil), []string{"a"}) {
+		t.Errorf("expected Some([a]), got %v", got)
+	}
+}
@@ -0,0 +1,17 @@
+package maybe
+
+import (
+	"context"
+
+	"github.com/magicdrive/maybe/result"
+)
+
+// TryCtx is like Try but returns None as soon as ctx ends. See result.TryCtx
+// for how the abandoned call is handed to onAbandon.
+func TryCtx[T any](ctx context.Context, f func(context.Context) (T, error), onAbandon ...func(T, error)) Maybe[T] {
+	r := result.TryCtx(ctx, f, func(e error) error { return e }, onAbandon...)
+	if r.IsErr() {
+		return None[T]()
+	}
+	return Some(r.Unwrap())
+}
@@ -0,0 +1,36 @@
+package maybe_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/magicdrive/maybe"
+)
+
+func TestTryCtx(t *testing.T) {
+	m := maybe.TryCtx(context.Background(), func(context.Context) (int, error) {
+		return 3, nil
+	})
+	if m.UnwrapOr(0) != 3 {
+		t.Errorf("expected Some(3), got %v", m)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	started := make(chan struct{})
+	release := make(chan struct{})
+	drained := make(chan struct{})
+	go func() {
+		<-started
+		cancel()
+	}()
+	m = maybe.TryCtx(ctx, func(context.Context) (int, error) {
+		close(started)
+		<-release
+		return 4, nil
+	}, func(int, error) { close(drained) })
+	if m.IsSome() {
+		t.Errorf("expected None after cancellation, got %v", m)
+	}
+	close(release)
+	<-drained
+}
@@ -0,0 +1,25 @@
+package maybe
+
+import "github.com/magicdrive/maybe/result"
+
+// Scope is the handle passed to the block of Do.
+type Scope struct {
+	inner *result.Scope[error]
+}
+
+// Do runs f and returns its value as Some. When Take inside f meets a None,
+// f is aborted and Do returns None. It shares the rules of result.Do.
+func Do[T any](f func(*Scope) T) Maybe[T] {
+	r := result.Do(func(s *result.Scope[error]) T {
+		return f(&Scope{inner: s})
+	})
+	if r.IsErr() {
+		return None[T]()
+	}
+	return Some(r.Unwrap())
+}
+
+// Take returns the value of a Some, or aborts the Do that owns s.
+func Take[T any](s *Scope, m Maybe[T]) T {
+	return result.Get(s.inner, ToResult(m, ErrNone))
+}
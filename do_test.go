@@ -0,0 +1,28 @@
+package maybe_test
+
+import (
+	"testing"
+
+	"github.com/magicdrive/maybe"
+)
+
+func TestDo(t *testing.T) {
+	config := map[string]int{"width": 3, "height": 4}
+
+	area := maybe.Do(func(s *maybe.Scope) int {
+		return maybe.Take(s, maybe.Get(config, "width")) * maybe.Take(s, maybe.Get(config, "height"))
+	})
+	if area.UnwrapOr(0) != 12 {
+		t.Errorf("expected Some(12), got %v", area)
+	}
+
+	reached := false
+	missing := maybe.Do(func(s *maybe.Scope) int {
+		d := maybe.Take(s, maybe.Get(config, "depth"))
+		reached = true
+		return d
+	})
+	if missing.IsSome() || reached {
+		t.Errorf("expected Do to abort with None, got %v", missing)
+	}
+}
@@ -0,0 +1,108 @@
+package flagx
+
+import (
+	"flag"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/magicdrive/maybe"
+)
+
+// Value is a flag.Value that leaves its target None until the flag is set.
+type Value[T any] struct {
+	target *maybe.Maybe[T]
+	parse  func(string) (T, error)
+}
+
+func (v *Value[T]) String() string {
+	if v == nil || v.target == nil || v.target.IsNone() {
+		return ""
+	}
+	return fmt.Sprint(v.target.Unwrap())
+}
+
+func (v *Value[T]) Set(s string) error {
+	x, err := v.parse(s)
+	if err != nil {
+		return err
+	}
+	*v.target = maybe.Some(x)
+	return nil
+}
+
+// SliceValue is a flag.Value that appends every occurrence of the flag.
+type SliceValue[T any] struct {
+	target *maybe.Maybe[[]T]
+	parse  func(string) (T, error)
+}
+
+func (v *SliceValue[T]) String() string {
+	if v == nil || v.target == nil || v.target.IsNone() {
+		return ""
+	}
+	return fmt.Sprint(v.target.Unwrap())
+}
+
+func (v *SliceValue[T]) Set(s string) error {
+	x, err := v.parse(s)
+	if err != nil {
+		return err
+	}
+	*v.target = maybe.Some(append(v.target.UnwrapOr(nil), x))
+	return nil
+}
+
+type boolValue struct {
+	Value[bool]
+}
+
+func (v *boolValue) IsBoolFlag() bool {
+	return true
+}
+
+func Var[T any](fs *flag.FlagSet, p *maybe.Maybe[T], name, usage string, parse func(string) (T, error)) {
+	fs.Var(&Value[T]{target: p, parse: parse}, name, usage)
+}
+
+func Maybe[T any](fs *flag.FlagSet, name, usage string, parse func(string) (T, error)) *maybe.Maybe[T] {
+	p := new(maybe.Maybe[T])
+	Var(fs, p, name, usage, parse)
+	return p
+}
+
+func SliceVar[T any](fs *flag.FlagSet, p *maybe.Maybe[[]T], name, usage string, parse func(string) (T, error)) {
+	fs.Var(&SliceValue[T]{target: p, parse: parse}, name, usage)
+}
+
+func Slice[T any](fs *flag.FlagSet, name, usage string, parse func(string) (T, error)) *maybe.Maybe[[]T] {
+	p := new(maybe.Maybe[[]T])
+	SliceVar(fs, p, name, usage, parse)
+	return p
+}
+
+func Int(fs *flag.FlagSet, name, usage string) *maybe.Maybe[int] {
+	return Maybe(fs, name, usage, strconv.Atoi)
+}
+
+func Float64(fs *flag.FlagSet, name, usage string) *maybe.Maybe[float64] {
+	return Maybe(fs, name, usage, func(s string) (float64, error) {
+		return strconv.ParseFloat(s, 64)
+	})
+}
+
+func String(fs *flag.FlagSet, name, usage string) *maybe.Maybe[string] {
+	return Maybe(fs, name, usage, func(s string) (string, error) {
+		return s, nil
+	})
+}
+
+func Bool(fs *flag.FlagSet, name, usage string) *maybe.Maybe[bool] {
+	p := new(maybe.Maybe[bool])
+	fs.Var(&boolValue{Value[bool]{target: p, parse: strconv.ParseBool}}, name, usage)
+	return p
+}
+
+func Duration(fs *flag.FlagSet, name, usage string) *maybe.Maybe[time.Duration] {
+	return Maybe(fs, name, usage, time.ParseDuration)
+}
@@ -0,0 +1,77 @@
+package flagx_test
+
+import (
+	"flag"
+	"io"
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/magicdrive/maybe/flagx"
+)
+
+func newFlagSet() *flag.FlagSet {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	return fs
+}
+
+func TestFlagsStayNoneUnlessGiven(t *testing.T) {
+	fs := newFlagSet()
+	port := flagx.Int(fs, "port", "port")
+	ratio := flagx.Float64(fs, "ratio", "ratio")
+	name := flagx.String(fs, "name", "name")
+	verbose := flagx.Bool(fs, "verbose", "verbose")
+	timeout := flagx.Duration(fs, "timeout", "timeout")
+
+	if err := fs.Parse([]string{"-port", "0", "-verbose", "-timeout", "3s"}); err != nil {
+		t.Fatal(err)
+	}
+	if !port.IsSome() || port.Unwrap() != 0 {
+		t.Errorf("expected Some(0) for explicitly passed zero")
+	}
+	if ratio.IsSome() || name.IsSome() {
+		t.Errorf("expected flags not given to stay None")
+	}
+	if !verbose.UnwrapOr(false) {
+		t.Errorf("expected -verbose to set Some(true)")
+	}
+	if timeout.UnwrapOr(0) != 3*time.Second {
+		t.Errorf("expected 3s, got %v", timeout.UnwrapOr(0))
+	}
+}
+
+func TestFlagParseError(t *testing.T) {
+	fs := newFlagSet()
+	port := flagx.Int(fs, "port", "port")
+	if err := fs.Parse([]string{"-port", "x"}); err == nil {
+		t.Errorf("expected parse error")
+	}
+	if port.IsSome() {
+		t.Errorf("expected failed flag to stay None")
+	}
+}
+
+func TestSliceFlag(t *testing.T) {
+	fs := newFlagSet()
+	ids := flagx.Slice(fs, "id", "ids", strconv.Atoi)
+	tags := flagx.Slice(fs, "tag", "tags", func(s string) (string, error) { return s, nil })
+
+	if err := fs.Parse([]string{"-id", "1", "-id", "2", "-id", "3"}); err != nil {
+		t.Fatal(err)
+	}
+	if got := ids.UnwrapOr(nil); !reflect.DeepEqual(got, []int{1, 2, 3}) {
+		t.Errorf("expected [1 2 3], got %v", got)
+	}
+	if tags.IsSome() {
+		t.Errorf("expected repeated flag not given to stay None")
+	}
+}
+
+func TestFlagDefaultsPrintWithoutPanic(t *testing.T) {
+	fs := newFlagSet()
+	flagx.Int(fs, "port", "port")
+	flagx.Slice(fs, "id", "ids", strconv.Atoi)
+	fs.PrintDefaults()
+}
@@ -0,0 +1,83 @@
+package maybe
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+)
+
+func typeName[T any]() string {
+	return reflect.TypeFor[T]().String()
+}
+
+// String renders Some(v) or None.
+func (m Maybe[T]) String() string {
+	if !m.valid {
+		return "None"
+	}
+	return fmt.Sprintf("Some(%v)", m.value)
+}
+
+// GoString renders m as a Go expression such as maybe.Some[int](42).
+func (m Maybe[T]) GoString() string {
+	if !m.valid {
+		return fmt.Sprintf("maybe.None[%s]()", typeName[T]())
+	}
+	return fmt.Sprintf("maybe.Some[%s](%#v)", typeName[T](), m.value)
+}
+
+// Format implements fmt.Formatter. %v renders Some(v)/None, %+v adds the
+// type parameter and %#v renders Go syntax. Other verbs apply to the value.
+func (m Maybe[T]) Format(f fmt.State, verb rune) {
+	switch {
+	case verb == 'v' && f.Flag('#'):
+		io.WriteString(f, m.GoString())
+	case verb == 'v' && f.Flag('+'):
+		if !m.valid {
+			fmt.Fprintf(f, "None[%s]", typeName[T]())
+			return
+		}
+		fmt.Fprintf(f, "Some[%s](%+v)", typeName[T](), m.value)
+	default:
+		if !m.valid {
+			io.WriteString(f, "None")
+			return
+		}
+		fmt.Fprintf(f, "Some("+fmt.FormatString(f, verb)+")", m.value)
+	}
+}
+
+// --- MaybePrimitive ---
+
+func (m MaybePrimitive[T]) String() string {
+	if m.value == nil {
+		return "None"
+	}
+	return fmt.Sprintf("Some(%v)", *m.value)
+}
+
+func (m MaybePrimitive[T]) GoString() string {
+	if m.value == nil {
+		return fmt.Sprintf("maybe.NonePrimitive[%s]()", typeName[T]())
+	}
+	return fmt.Sprintf("maybe.SomePrimitive[%s](%#v)", typeName[T](), *m.value)
+}
+
+func (m MaybePrimitive[T]) Format(f fmt.State, verb rune) {
+	switch {
+	case verb == 'v' && f.Flag('#'):
+		io.WriteString(f, m.GoString())
+	case verb == 'v' && f.Flag('+'):
+		if m.value == nil {
+			fmt.Fprintf(f, "None[%s]", typeName[T]())
+			return
+		}
+		fmt.Fprintf(f, "Some[%s](%+v)", typeName[T](), *m.value)
+	default:
+		if m.value == nil {
+			io.WriteString(f, "None")
+			return
+		}
+		fmt.Fprintf(f, "Some("+fmt.FormatString(f, verb)+")", *m.value)
+	}
+}
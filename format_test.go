@@ -0,0 +1,47 @@
+package maybe_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/magicdrive/maybe"
+)
+
+func TestMaybeFormat(t *testing.T) {
+	cases := []struct {
+		format string
+		value  any
+		want   string
+	}{
+		{"%v", maybe.Some(42), "Some(42)"},
+		{"%v", maybe.None[int](), "None"},
+		{"%s", maybe.Some("x"), "Some(x)"},
+		{"%q", maybe.Some("x"), `Some("x")`},
+		{"%03d", maybe.Some(7), "Some(007)"},
+		{"%+v", maybe.Some(42), "Some[int](42)"},
+		{"%+v", maybe.None[string](), "None[string]"},
+		{"%#v", maybe.Some(42), "maybe.Some[int](42)"},
+		{"%#v", maybe.Some("x"), `maybe.Some[string]("x")`},
+		{"%#v", maybe.None[int](), "maybe.None[int]()"},
+		{"%v", maybe.Some(maybe.Some(1)), "Some(Some(1))"},
+		{"%v", maybe.SomePrimitive(42), "Some(42)"},
+		{"%v", maybe.NonePrimitive[bool](), "None"},
+		{"%+v", maybe.SomePrimitive(UserID(3)), "Some[maybe_test.UserID](3)"},
+		{"%#v", maybe.SomePrimitive(1.5), "maybe.SomePrimitive[float64](1.5)"},
+		{"%#v", maybe.NonePrimitive[string](), "maybe.NonePrimitive[string]()"},
+	}
+	for _, c := range cases {
+		if got := fmt.Sprintf(c.format, c.value); got != c.want {
+			t.Errorf("%s: expected %q, got %q", c.format, c.want, got)
+		}
+	}
+}
+
+func TestMaybeString(t *testing.T) {
+	if s := maybe.Some(1).String(); s != "Some(1)" {
+		t.Errorf("expected Some(1), got %s", s)
+	}
+	if s := maybe.NonePrimitive[int]().String(); s != "None" {
+		t.Errorf("expected None, got %s", s)
+	}
+}
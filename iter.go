@@ -0,0 +1,42 @@
+package maybe
+
+import "iter"
+
+// All yields the contained value once for Some and nothing for None.
+func (m Maybe[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if m.valid {
+			yield(m.value)
+		}
+	}
+}
+
+func (m MaybePrimitive[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if m.value != nil {
+			yield(*m.value)
+		}
+	}
+}
+
+// Values yields the contained value of every Some in seq and skips None.
+func Values[T any](seq iter.Seq[Maybe[T]]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for m := range seq {
+			if m.valid && !yield(m.value) {
+				return
+			}
+		}
+	}
+}
+
+// FilterMapSeq applies f to every element of seq and yields the Some results.
+func FilterMapSeq[T any, U any](seq iter.Seq[T], f func(T) Maybe[U]) iter.Seq[U] {
+	return func(yield func(U) bool) {
+		for v := range seq {
+			if m := f(v); m.valid && !yield(m.value) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package maybe_test
+
+import (
+	"maps"
+	"reflect"
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/magicdrive/maybe"
+)
+
+func TestMaybeAll(t *testing.T) {
+	if got := slices.Collect(maybe.Some(1).All()); !reflect.DeepEqual(got, []int{1}) {
+		t.Errorf("expected [1], got %v", got)
+	}
+	if got := slices.Collect(maybe.None[int]().All()); len(got) != 0 {
+		t.Errorf("expected no elements, got %v", got)
+	}
+	if got := slices.Collect(maybe.SomePrimitive("a").All()); !reflect.DeepEqual(got, []string{"a"}) {
+		t.Errorf("expected [a], got %v", got)
+	}
+}
+
+func TestValues(t *testing.T) {
+	ms := []maybe.Maybe[int]{maybe.Some(1), maybe.None[int](), maybe.Some(3)}
+	got := slices.Collect(maybe.Values(slices.Values(ms)))
+	if !reflect.DeepEqual(got, []int{1, 3}) {
+		t.Errorf("expected [1 3], got %v", got)
+	}
+
+	for v := range maybe.Values(slices.Values(ms)) {
+		if v != 1 {
+			t.Errorf("expected to stop after first element")
+		}
+		break
+	}
+}
+
+func TestFilterMapSeq(t *testing.T) {
+	m := map[string]string{"a": "1", "b": "x", "c": "3"}
+	parsed := maybe.FilterMapSeq(maps.Values(m), func(s string) maybe.Maybe[int] {
+		return maybe.Try(func() (int, error) { return strconv.Atoi(s) })
+	})
+	got := slices.Sorted(parsed)
+	if !reflect.DeepEqual(got, []int{1, 3}) {
+		t.Errorf("expected [1 3], got %v", got)
+	}
+}
@@ -0,0 +1,96 @@
+package maybe
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+var jsonNull = []byte("null")
+
+// IsZero reports whether m is None. It lets `json:",omitzero"` drop None fields.
+func (m Maybe[T]) IsZero() bool {
+	return !m.valid
+}
+
+func (m Maybe[T]) MarshalJSON() ([]byte, error) {
+	if !m.valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(m.value)
+}
+
+func (m *Maybe[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), jsonNull) {
+		*m = None[T]()
+		return nil
+	}
+	var v T
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*m = Some(v)
+	return nil
+}
+
+// --- MaybePrimitive ---
+
+func (m MaybePrimitive[T]) IsZero() bool {
+	return m.value == nil
+}
+
+func (m MaybePrimitive[T]) MarshalJSON() ([]byte, error) {
+	if m.value == nil {
+		return []byte("null"), nil
+	}
+	return json.Marshal(*m.value)
+}
+
+func (m *MaybePrimitive[T]) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if bytes.Equal(data, jsonNull) {
+		*m = NonePrimitive[T]()
+		return nil
+	}
+	var v T
+	if err := checkPrimitiveJSON(data, reflect.TypeOf(v)); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*m = SomePrimitive(v)
+	return nil
+}
+
+func checkPrimitiveJSON(data []byte, t reflect.Type) error {
+	token := "value"
+	if len(data) == 0 {
+		return fmt.Errorf("maybe: cannot unmarshal empty JSON into MaybePrimitive[%s]", t)
+	}
+	switch c := data[0]; {
+	case c == '"':
+		token = "string"
+	case c == 't' || c == 'f':
+		token = "bool"
+	case c == '-' || ('0' <= c && c <= '9'):
+		token = "number"
+	}
+
+	ok := false
+	switch t.Kind() {
+	case reflect.Int:
+		ok = token == "number" && !bytes.ContainsAny(data, ".eE")
+	case reflect.Float64:
+		ok = token == "number"
+	case reflect.String:
+		ok = token == "string"
+	case reflect.Bool:
+		ok = token == "bool"
+	}
+	if !ok {
+		return fmt.Errorf("maybe: cannot unmarshal JSON %s %s into MaybePrimitive[%s]", token, data, t)
+	}
+	return nil
+}
@@ -0,0 +1,143 @@
+package maybe_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/magicdrive/maybe"
+)
+
+type profileDTO struct {
+	Name     string                      `json:"name"`
+	Age      maybe.Maybe[int]            `json:"age"`
+	Nickname maybe.Maybe[string]         `json:"nickname,omitzero"`
+	Tags     maybe.Maybe[[]string]       `json:"tags,omitzero"`
+	Extra    maybe.Maybe[map[string]int] `json:"extra"`
+}
+
+func TestMaybeMarshalJSON(t *testing.T) {
+	dto := profileDTO{
+		Name:     "taro",
+		Age:      maybe.Some(20),
+		Nickname: maybe.None[string](),
+		Tags:     maybe.Some([]string{"a"}),
+	}
+	b, err := json.Marshal(dto)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"name":"taro","age":20,"tags":["a"],"extra":null}`
+	if string(b) != want {
+		t.Errorf("expected %s, got %s", want, b)
+	}
+}
+
+func TestMaybeUnmarshalJSON(t *testing.T) {
+	var dto profileDTO
+	if err := json.Unmarshal([]byte(`{"name":"taro","age":null,"nickname":"tt"}`), &dto); err != nil {
+		t.Fatal(err)
+	}
+	if dto.Age.IsSome() {
+		t.Errorf("expected null to decode as None")
+	}
+	if dto.Nickname.UnwrapOr("") != "tt" {
+		t.Errorf("expected Some(tt), got %v", dto.Nickname.UnwrapOr(""))
+	}
+	if dto.Tags.IsSome() {
+		t.Errorf("expected missing key to decode as None")
+	}
+
+	var m maybe.Maybe[int]
+	if err := json.Unmarshal([]byte(`"x"`), &m); err == nil {
+		t.Errorf("expected type error")
+	}
+}
+
+func TestMaybeJSONRoundTrip(t *testing.T) {
+	in := maybe.Some(maybe.Some(3))
+	b, err := json.Marshal(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var out maybe.Maybe[maybe.Maybe[int]]
+	if err := json.Unmarshal(b, &out); err != nil {
+		t.Fatal(err)
+	}
+	if maybe.Flatten(out).UnwrapOr(0) != 3 {
+		t.Errorf("expected round trip to keep 3, got %s", b)
+	}
+}
+
+type UserID int
+
+type accountDTO struct {
+	ID     maybe.MaybePrimitive[UserID]  `json:"id"`
+	Score  maybe.MaybePrimitive[float64] `json:"score,omitzero"`
+	Active maybe.MaybePrimitive[bool]    `json:"active"`
+}
+
+func TestMaybePrimitiveJSONRoundTrip(t *testing.T) {
+	in := accountDTO{ID: maybe.SomePrimitive(UserID(7)), Active: maybe.SomePrimitive(false)}
+	b, err := json.Marshal(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != `{"id":7,"active":false}` {
+		t.Errorf("unexpected JSON: %s", b)
+	}
+
+	var out accountDTO
+	if err := json.Unmarshal(b, &out); err != nil {
+		t.Fatal(err)
+	}
+	if out.ID.UnwrapOr(0) != UserID(7) || out.Score.IsSome() || out.Active.UnwrapOr(true) {
+		t.Errorf("round trip mismatch: %+v", out)
+	}
+
+	if err := json.Unmarshal([]byte(`{"id":null}`), &out); err != nil {
+		t.Fatal(err)
+	}
+	if out.ID.IsSome() {
+		t.Errorf("expected null to decode as NonePrimitive")
+	}
+}
+
+func TestMaybePrimitiveUnmarshalJSONKindMismatch(t *testing.T) {
+	cases := []struct {
+		name string
+		data string
+		dst  json.Unmarshaler
+	}{
+		{"string into int", `"42"`, new(maybe.MaybePrimitive[int])},
+		{"float into int", `4.2`, new(maybe.MaybePrimitive[UserID])},
+		{"number into string", `42`, new(maybe.MaybePrimitive[string])},
+		{"string into bool", `"true"`, new(maybe.MaybePrimitive[bool])},
+		{"object into float64", `{}`, new(maybe.MaybePrimitive[float64])},
+	}
+	for _, c := range cases {
+		if err := json.Unmarshal([]byte(c.data), c.dst); err == nil {
+			t.Errorf("%s: expected error", c.name)
+		}
+	}
+
+	var m maybe.MaybePrimitive[int]
+	err := json.Unmarshal([]byte(`"42"`), &m)
+	if err == nil || err.Error() != `maybe: cannot unmarshal JSON string "42" into MaybePrimitive[int]` {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestMarshalJSONReturnsFreshNull(t *testing.T) {
+	b, _ := maybe.None[int]().MarshalJSON()
+	copy(b, "xxxx")
+	bp, _ := maybe.NonePrimitive[int]().MarshalJSON()
+	copy(bp, "xxxx")
+
+	if b, _ := maybe.None[int]().MarshalJSON(); string(b) != "null" {
+		t.Errorf("expected null, got %s", b)
+	}
+	var m maybe.Maybe[int]
+	if err := json.Unmarshal([]byte(`null`), &m); err != nil || m.IsSome() {
+		t.Errorf("expected null to still decode as None, got %v (%v)", m, err)
+	}
+}
@@ -0,0 +1,126 @@
+package maybe
+
+// Get looks k up in m.
+func Get[K comparable, V any](m map[K]V, k K) Maybe[V] {
+	v, ok := m[k]
+	return FromValue(v, ok)
+}
+
+// At returns xs[i]. A negative i counts from the end, so At(xs, -1) is the
+// last element.
+func At[T any](xs []T, i int) Maybe[T] {
+	if i < 0 {
+		i += len(xs)
+	}
+	if i < 0 || i >= len(xs) {
+		return None[T]()
+	}
+	return Some(xs[i])
+}
+
+func First[T any](xs []T) Maybe[T] {
+	return At(xs, 0)
+}
+
+func Last[T any](xs []T) Maybe[T] {
+	return At(xs, -1)
+}
+
+// Find returns the first element of xs that satisfies pred.
+func Find[T any](xs []T, pred func(T) bool) Maybe[T] {
+	for _, x := range xs {
+		if pred(x) {
+			return Some(x)
+		}
+	}
+	return None[T]()
+}
+
+// Cast returns Some when v holds a T.
+func Cast[T any](v any) Maybe[T] {
+	t, ok := v.(T)
+	return FromValue(t, ok)
+}
+
+// FromPtr returns Some(*p), or None when p is nil.
+func FromPtr[T any](p *T) Maybe[T] {
+	if p == nil {
+		return None[T]()
+	}
+	return Some(*p)
+}
+
+// ToPtr returns a pointer to a copy of the value, or nil for None.
+func (m Maybe[T]) ToPtr() *T {
+	if !m.valid {
+		return nil
+	}
+	v := m.value
+	return &v
+}
+
+// FromNonZero returns None when v is the zero value of T.
+func FromNonZero[T comparable](v T) Maybe[T] {
+	var zero T
+	return FromValue(v, v != zero)
+}
+
+// --- MaybePrimitive ---
+
+func GetPrimitive[K comparable, V Primitive](m map[K]V, k K) MaybePrimitive[V] {
+	v, ok := m[k]
+	return FromValuePrimitive(v, ok)
+}
+
+func AtPrimitive[T Primitive](xs []T, i int) MaybePrimitive[T] {
+	if i < 0 {
+		i += len(xs)
+	}
+	if i < 0 || i >= len(xs) {
+		return NonePrimitive[T]()
+	}
+	return SomePrimitive(xs[i])
+}
+
+func FirstPrimitive[T Primitive](xs []T) MaybePrimitive[T] {
+	return AtPrimitive(xs, 0)
+}
+
+func LastPrimitive[T Primitive](xs []T) MaybePrimitive[T] {
+	return AtPrimitive(xs, -1)
+}
+
+func FindPrimitive[T Primitive](xs []T, pred func(T) bool) MaybePrimitive[T] {
+	for _, x := range xs {
+		if pred(x) {
+			return SomePrimitive(x)
+		}
+	}
+	return NonePrimitive[T]()
+}
+
+func CastPrimitive[T Primitive](v any) MaybePrimitive[T] {
+	t, ok := v.(T)
+	return FromValuePrimitive(t, ok)
+}
+
+// FromPtrPrimitive copies *p so the result does not alias p.
+func FromPtrPrimitive[T Primitive](p *T) MaybePrimitive[T] {
+	if p == nil {
+		return NonePrimitive[T]()
+	}
+	return SomePrimitive(*p)
+}
+
+func (m MaybePrimitive[T]) ToPtr() *T {
+	if m.value == nil {
+		return nil
+	}
+	v := *m.value
+	return &v
+}
+
+func FromNonZeroPrimitive[T Primitive](v T) MaybePrimitive[T] {
+	var zero T
+	return FromValuePrimitive(v, v != zero)
+}
@@ -0,0 +1,119 @@
+package maybe_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/magicdrive/maybe"
+)
+
+func TestGet(t *testing.T) {
+	m := map[string]int{"a": 1, "zero": 0}
+	if maybe.Get(m, "a").UnwrapOr(-1) != 1 {
+		t.Errorf("expected Some(1)")
+	}
+	if !maybe.Get(m, "zero").IsSome() {
+		t.Errorf("expected Some(0) for present zero value")
+	}
+	if maybe.Get(m, "b").IsSome() {
+		t.Errorf("expected None for missing key")
+	}
+	if maybe.GetPrimitive(m, "a").UnwrapOr(-1) != 1 {
+		t.Errorf("expected SomePrimitive(1)")
+	}
+}
+
+func TestAtFirstLast(t *testing.T) {
+	xs := []string{"a", "b", "c"}
+	cases := []struct {
+		i    int
+		want maybe.Maybe[string]
+	}{
+		{0, maybe.Some("a")},
+		{2, maybe.Some("c")},
+		{-1, maybe.Some("c")},
+		{-3, maybe.Some("a")},
+		{3, maybe.None[string]()},
+		{-4, maybe.None[string]()},
+	}
+	for _, c := range cases {
+		if got := maybe.At(xs, c.i); got != c.want {
+			t.Errorf("At(%d): expected %v, got %v", c.i, c.want, got)
+		}
+	}
+	if maybe.First(xs).Unwrap() != "a" || maybe.Last(xs).Unwrap() != "c" {
+		t.Errorf("unexpected First/Last")
+	}
+	if maybe.First[int](nil).IsSome() || maybe.Last([]int{}).IsSome() {
+		t.Errorf("expected None for empty slices")
+	}
+	if maybe.AtPrimitive([]int{1, 2}, -1).UnwrapOr(0) != 2 {
+		t.Errorf("expected SomePrimitive(2)")
+	}
+	if maybe.LastPrimitive([]bool{}).IsSome() {
+		t.Errorf("expected NonePrimitive")
+	}
+}
+
+func TestFind(t *testing.T) {
+	xs := []int{1, 4, 6}
+	even := func(x int) bool { return x%2 == 0 }
+	if maybe.Find(xs, even).UnwrapOr(0) != 4 {
+		t.Errorf("expected first even element")
+	}
+	if maybe.FindPrimitive(xs, func(x int) bool { return x > 10 }).IsSome() {
+		t.Errorf("expected no match")
+	}
+}
+
+func TestCast(t *testing.T) {
+	var v any = 42
+	if maybe.Cast[int](v).UnwrapOr(0) != 42 {
+		t.Errorf("expected Some(42)")
+	}
+	if maybe.Cast[string](v).IsSome() {
+		t.Errorf("expected None for mismatched type")
+	}
+	if !maybe.Cast[fmt.Stringer](maybe.Some(1)).IsSome() {
+		t.Errorf("expected interface assertion to succeed")
+	}
+	if maybe.CastPrimitive[UserID](v).IsSome() {
+		t.Errorf("expected None: int is not UserID")
+	}
+}
+
+func TestFromPtrToPtr(t *testing.T) {
+	x := 5
+	m := maybe.FromPtr(&x)
+	x = 6
+	if m.Unwrap() != 5 {
+		t.Errorf("expected FromPtr to copy the value")
+	}
+	if maybe.FromPtr[int](nil).IsSome() {
+		t.Errorf("expected None for nil pointer")
+	}
+	if p := m.ToPtr(); p == nil || *p != 5 {
+		t.Errorf("expected pointer to 5")
+	}
+	if maybe.None[int]().ToPtr() != nil {
+		t.Errorf("expected nil pointer for None")
+	}
+
+	mp := maybe.FromPtrPrimitive(&x)
+	*mp.ToPtr() = 100
+	if mp.Unwrap() != 6 {
+		t.Errorf("expected ToPtr to return a copy")
+	}
+}
+
+func TestFromNonZero(t *testing.T) {
+	if maybe.FromNonZero("").IsSome() || maybe.FromNonZero(0).IsSome() {
+		t.Errorf("expected None for zero values")
+	}
+	if maybe.FromNonZero("x").UnwrapOr("") != "x" {
+		t.Errorf("expected Some(x)")
+	}
+	if maybe.FromNonZeroPrimitive(false).IsSome() {
+		t.Errorf("expected NonePrimitive for false")
+	}
+}
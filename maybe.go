@@ -1,6 +1,14 @@
 package maybe
 
-import "github.com/magicdrive/maybe/result"
+import (
+	"errors"
+	"fmt"
+
+	"github.com/magicdrive/maybe/result"
+)
+
+// ErrNone is the panic value of Unwrap on None and is wrapped by Expect.
+var ErrNone = errors.New("called Unwrap on None")
 
 type Maybe[T any] struct {
 	value T
@@ -22,6 +30,15 @@ func Try[T any](f func() (T, error)) Maybe[T] {
 	return Some(v)
 }
 
+// TryRecover is like Try but also returns None when f panics.
+func TryRecover[T any](f func() (T, error)) Maybe[T] {
+	r := result.TryRecover(f)
+	if r.IsErr() {
+		return None[T]()
+	}
+	return Some(r.Unwrap())
+}
+
 func Some[T any](v T) Maybe[T] {
 	return Maybe[T]{value: v, valid: true}
 }
@@ -41,7 +58,15 @@ func (m Maybe[T]) IsNone() bool {
 
 func (m Maybe[T]) Unwrap() T {
 	if !m.valid {
-		panic("called Unwrap on None")
+		panic(ErrNone)
+	}
+	return m.value
+}
+
+// Expect is like Unwrap but prefixes the panic value with msg.
+func (m Maybe[T]) Expect(msg string) T {
+	if !m.valid {
+		panic(fmt.Errorf("%s: %w", msg, ErrNone))
 	}
 	return m.value
 }
@@ -53,6 +78,11 @@ func (m Maybe[T]) UnwrapOr(def T) T {
 	return m.value
 }
 
+// Get returns the value of m and whether it is Some, like a comma-ok lookup.
+func (m Maybe[T]) Get() (T, bool) {
+	return m.value, m.valid
+}
+
 func (m Maybe[T]) OrElse(other Maybe[T]) Maybe[T] {
 	if m.valid {
 		return m
@@ -160,7 +190,14 @@ func (m MaybePrimitive[T]) IsNone() bool {
 
 func (m MaybePrimitive[T]) Unwrap() T {
 	if m.value == nil {
-		panic("called Unwrap on None")
+		panic(ErrNone)
+	}
+	return *m.value
+}
+
+func (m MaybePrimitive[T]) Expect(msg string) T {
+	if m.value == nil {
+		panic(fmt.Errorf("%s: %w", msg, ErrNone))
 	}
 	return *m.value
 }
@@ -172,6 +209,14 @@ func (m MaybePrimitive[T]) UnwrapOr(def T) T {
 	return *m.value
 }
 
+func (m MaybePrimitive[T]) Get() (T, bool) {
+	if m.value == nil {
+		var zero T
+		return zero, false
+	}
+	return *m.value, true
+}
+
 func (m MaybePrimitive[T]) OrElse(other MaybePrimitive[T]) MaybePrimitive[T] {
 	if m.value != nil {
 		return m
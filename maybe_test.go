@@ -208,3 +208,27 @@ func TestFlatten(t *testing.T) {
 		t.Errorf("expected Flatten(None) to be None")
 	}
 }
+
+func recoverError(f func()) (err error) {
+	defer func() {
+		err, _ = recover().(error)
+	}()
+	f()
+	return nil
+}
+
+func TestExpect(t *testing.T) {
+	if maybe.Some(1).Expect("need value") != 1 {
+		t.Errorf("expected 1")
+	}
+
+	err := recoverError(func() { maybe.None[int]().Expect("config port missing") })
+	if !errors.Is(err, maybe.ErrNone) || err.Error() != "config port missing: called Unwrap on None" {
+		t.Errorf("unexpected panic value: %v", err)
+	}
+
+	err = recoverError(func() { maybe.NonePrimitive[string]().Expect("name missing") })
+	if !errors.Is(err, maybe.ErrNone) || err.Error() != "name missing: called Unwrap on None" {
+		t.Errorf("unexpected panic value: %v", err)
+	}
+}
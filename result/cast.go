@@ -0,0 +1,32 @@
+package result
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// TypeMismatchError is produced by Cast when the dynamic type of a value
+// is not the expected one.
+type TypeMismatchError struct {
+	Expected reflect.Type
+	Actual   reflect.Type
+}
+
+func (e *TypeMismatchError) Error() string {
+	actual := "<nil>"
+	if e.Actual != nil {
+		actual = e.Actual.String()
+	}
+	return fmt.Sprintf("type mismatch: expected %s, got %s", e.Expected, actual)
+}
+
+// Cast asserts v to T. On mismatch the *TypeMismatchError is passed to wrap.
+func Cast[T any, E error](v any, wrap func(error) E) Result[T, E] {
+	if t, ok := v.(T); ok {
+		return Ok[T, E](t)
+	}
+	return Err[T](wrap(&TypeMismatchError{
+		Expected: reflect.TypeFor[T](),
+		Actual:   reflect.TypeOf(v),
+	}))
+}
@@ -0,0 +1,35 @@
+package result_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/magicdrive/maybe/result"
+)
+
+func TestCast(t *testing.T) {
+	id := func(e error) error { return e }
+
+	var v any = 42
+	if result.Cast[int](v, id).Unwrap() != 42 {
+		t.Errorf("expected Ok(42)")
+	}
+
+	r := result.Cast[string](v, id)
+	var mismatch *result.TypeMismatchError
+	if !errors.As(r.UnwrapErr(), &mismatch) {
+		t.Fatalf("expected *TypeMismatchError, got %T", r.UnwrapErr())
+	}
+	if msg := mismatch.Error(); msg != "type mismatch: expected string, got int" {
+		t.Errorf("unexpected message: %s", msg)
+	}
+
+	if msg := result.Cast[error](nil, id).UnwrapErr().Error(); msg != "type mismatch: expected error, got <nil>" {
+		t.Errorf("unexpected message: %s", msg)
+	}
+
+	wrapped := result.Cast[int]("x", func(e error) MyErr { return MyErr{msg: "bad input: " + e.Error()} })
+	if wrapped.UnwrapErr().msg != "bad input: type mismatch: expected int, got string" {
+		t.Errorf("unexpected wrapped error: %v", wrapped.UnwrapErr())
+	}
+}
@@ -0,0 +1,80 @@
+package result
+
+import (
+	"iter"
+	"strings"
+)
+
+// Errors aggregates several errors of the same type. Like errors.Join it
+// renders one error per line and exposes Unwrap() []error, so errors.Is and
+// errors.As search every element.
+type Errors[E error] []E
+
+func (es Errors[E]) Error() string {
+	msgs := make([]string, len(es))
+	for i, e := range es {
+		msgs[i] = e.Error()
+	}
+	return strings.Join(msgs, "\n")
+}
+
+func (es Errors[E]) Unwrap() []error {
+	errs := make([]error, len(es))
+	for i, e := range es {
+		errs[i] = e
+	}
+	return errs
+}
+
+// Collect returns Ok with every value when all of rs are Ok, or the first Err.
+func Collect[T any, E error](rs []Result[T, E]) Result[[]T, E] {
+	values := make([]T, 0, len(rs))
+	for _, r := range rs {
+		if !r.ok {
+			return Err[[]T](r.err)
+		}
+		values = append(values, r.value)
+	}
+	return Ok[[]T, E](values)
+}
+
+// CollectAll is like Collect but reports every Err instead of only the first.
+func CollectAll[T any, E error](rs []Result[T, E]) Result[[]T, Errors[E]] {
+	values := make([]T, 0, len(rs))
+	var errs Errors[E]
+	for _, r := range rs {
+		if !r.ok {
+			errs = append(errs, r.err)
+			continue
+		}
+		values = append(values, r.value)
+	}
+	if len(errs) > 0 {
+		return Err[[]T](errs)
+	}
+	return Ok[[]T, Errors[E]](values)
+}
+
+// CollectSeq is like Collect but stops consuming seq at the first Err.
+func CollectSeq[T any, E error](seq iter.Seq[Result[T, E]]) Result[[]T, E] {
+	values := []T{}
+	for r := range seq {
+		if !r.ok {
+			return Err[[]T](r.err)
+		}
+		values = append(values, r.value)
+	}
+	return Ok[[]T, E](values)
+}
+
+// Partition splits rs into the values of its Oks and the errors of its Errs.
+func Partition[T any, E error](rs []Result[T, E]) (oks []T, errs []E) {
+	for _, r := range rs {
+		if r.ok {
+			oks = append(oks, r.value)
+		} else {
+			errs = append(errs, r.err)
+		}
+	}
+	return oks, errs
+}
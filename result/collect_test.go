@@ -0,0 +1,73 @@
+package result_test
+
+import (
+	"errors"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/magicdrive/maybe/result"
+)
+
+func TestCollect(t *testing.T) {
+	ok := result.Collect([]result.Result[int, error]{
+		result.Ok[int, error](1),
+		result.Ok[int, error](2),
+	})
+	if !reflect.DeepEqual(ok.Unwrap(), []int{1, 2}) {
+		t.Errorf("expected Ok([1 2]), got %v", ok)
+	}
+
+	first := errors.New("first")
+	failed := result.Collect([]result.Result[int, error]{
+		result.Ok[int, error](1),
+		result.Err[int](first),
+		result.Err[int](errors.New("second")),
+	})
+	if failed.UnwrapErr() != first {
+		t.Errorf("expected first error, got %v", failed)
+	}
+}
+
+func TestCollectAll(t *testing.T) {
+	e1 := &MyErr{msg: "a"}
+	e2 := &MyErr{msg: "b"}
+	r := result.CollectAll([]result.Result[int, *MyErr]{
+		result.Err[int](e1),
+		result.Ok[int, *MyErr](2),
+		result.Err[int](e2),
+	})
+	errs := r.UnwrapErr()
+	if len(errs) != 2 || errs[0] != e1 || errs[1] != e2 {
+		t.Errorf("expected both errors, got %v", errs)
+	}
+	if errs.Error() != "a\nb" {
+		t.Errorf("expected joined message, got %q", errs.Error())
+	}
+	if !errors.Is(errs, e2) {
+		t.Errorf("expected errors.Is to find the second error")
+	}
+
+	ok := result.CollectAll([]result.Result[int, *MyErr]{result.Ok[int, *MyErr](1)})
+	if !reflect.DeepEqual(ok.Unwrap(), []int{1}) {
+		t.Errorf("expected Ok([1]), got %v", ok)
+	}
+}
+
+func TestCollectSeqStopsAtFirstErr(t *testing.T) {
+	var pulled int
+	seq := func(yield func(result.Result[int, error]) bool) {
+		for _, s := range []string{"1", "x", "3"} {
+			pulled++
+			if !yield(result.From(strconv.Atoi(s))) {
+				return
+			}
+		}
+	}
+	if result.CollectSeq(seq).IsOk() {
+		t.Errorf("expected Err")
+	}
+	if pulled != 2 {
+		t.Errorf("expected to stop after 2 elements, pulled %d", pulled)
+	}
+}
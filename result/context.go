@@ -0,0 +1,77 @@
+package result
+
+import (
+	"context"
+	"errors"
+)
+
+type tryOutcome[T any] struct {
+	value    T
+	err      error
+	panicErr error
+}
+
+// TryCtx runs f in its own goroutine and returns as soon as either f finishes
+// or ctx ends. In the latter case the result is an Err wrapping ctx.Err(),
+// f keeps running, and its eventual outcome is handed to onAbandon so that
+// resources it returns can be released. A panic in f is re-raised as a
+// *PanicError carrying the stack of f's goroutine, except that a Get abort
+// is re-raised unchanged so that an enclosing Do still ends.
+func TryCtx[T any, E error](
+	ctx context.Context,
+	f func(context.Context) (T, error),
+	wrap func(error) E,
+	onAbandon ...func(T, error),
+) Result[T, E] {
+	if err := ctx.Err(); err != nil {
+		return Err[T](wrap(err))
+	}
+
+	ch := make(chan tryOutcome[T], 1)
+	go func() {
+		var o tryOutcome[T]
+		defer func() {
+			if v := recover(); isScopeAbort(v) {
+				o.panicErr = v.(error)
+			} else if v != nil {
+				o.panicErr = newPanicError(v)
+			}
+			ch <- o
+		}()
+		o.value, o.err = f(ctx)
+	}()
+
+	select {
+	case o := <-ch:
+		if o.panicErr != nil {
+			panic(o.panicErr)
+		}
+		if o.err != nil {
+			return Err[T](wrap(o.err))
+		}
+		return Ok[T, E](o.value)
+	case <-ctx.Done():
+		if len(onAbandon) > 0 {
+			go func() {
+				o := <-ch
+				if o.panicErr != nil {
+					o.err = o.panicErr
+				}
+				for _, fn := range onAbandon {
+					fn(o.value, o.err)
+				}
+			}()
+		}
+		return Err[T](wrap(ctx.Err()))
+	}
+}
+
+// IsCanceled reports whether r is an Err caused by context.Canceled.
+func (r Result[T, E]) IsCanceled() bool {
+	return !r.ok && errors.Is(r.err, context.Canceled)
+}
+
+// IsDeadlineExceeded reports whether r is an Err caused by context.DeadlineExceeded.
+func (r Result[T, E]) IsDeadlineExceeded() bool {
+	return !r.ok && errors.Is(r.err, context.DeadlineExceeded)
+}
@@ -0,0 +1,108 @@
+package result_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/magicdrive/maybe/result"
+)
+
+func identity(e error) error { return e }
+
+func TestTryCtxSuccess(t *testing.T) {
+	r := result.TryCtx(context.Background(), func(context.Context) (int, error) {
+		return 1, nil
+	}, identity)
+	if r.Unwrap() != 1 {
+		t.Errorf("expected Ok(1), got %v", r)
+	}
+
+	boom := errors.New("boom")
+	r = result.TryCtx(context.Background(), func(context.Context) (int, error) {
+		return 0, boom
+	}, identity)
+	if r.UnwrapErr() != boom || r.IsCanceled() {
+		t.Errorf("expected plain Err(boom), got %v", r)
+	}
+}
+
+func TestTryCtxCanceledBeforeStart(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	called := false
+	r := result.TryCtx(ctx, func(context.Context) (int, error) {
+		called = true
+		return 1, nil
+	}, identity)
+	if !r.IsCanceled() || called {
+		t.Errorf("expected canceled Err without running f")
+	}
+}
+
+func TestTryCtxDeadlineAndDrain(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	release := make(chan struct{})
+	drained := make(chan int, 1)
+	start := time.Now()
+	r := result.TryCtx(ctx, func(context.Context) (int, error) {
+		<-release
+		return 7, nil
+	}, func(e error) MyErr {
+		return MyErr{msg: e.Error()}
+	}, func(v int, err error) {
+		drained <- v
+	})
+
+	if time.Since(start) > time.Second {
+		t.Errorf("expected TryCtx to return at the deadline")
+	}
+	if r.UnwrapErr().msg != context.DeadlineExceeded.Error() {
+		t.Errorf("expected deadline error, got %v", r)
+	}
+
+	close(release)
+	select {
+	case v := <-drained:
+		if v != 7 {
+			t.Errorf("expected drained value 7, got %d", v)
+		}
+	case <-time.After(time.Second):
+		t.Errorf("expected abandoned result to be drained")
+	}
+}
+
+func TestIsCanceledAndDeadlineExceeded(t *testing.T) {
+	canceled := result.Err[int](errors.Join(errors.New("x"), context.Canceled))
+	if !canceled.IsCanceled() || canceled.IsDeadlineExceeded() {
+		t.Errorf("expected canceled only")
+	}
+	deadline := result.Err[int](context.DeadlineExceeded)
+	if !deadline.IsDeadlineExceeded() || deadline.IsCanceled() {
+		t.Errorf("expected deadline exceeded only")
+	}
+	if result.Ok[int, error](1).IsCanceled() {
+		t.Errorf("expected Ok not to be canceled")
+	}
+}
+
+func panickingWorker(context.Context) (int, error) {
+	panic("boom")
+}
+
+func TestTryCtxRepanicsWithWorkerStack(t *testing.T) {
+	err := recoverError(func() {
+		result.TryCtx(context.Background(), panickingWorker, identity)
+	})
+	var pe *result.PanicError
+	if !errors.As(err, &pe) || pe.Value != "boom" {
+		t.Fatalf("expected *PanicError(boom), got %v", err)
+	}
+	if !strings.Contains(string(pe.Stack), "panickingWorker") {
+		t.Errorf("expected worker stack, got %s", pe.Stack)
+	}
+}
@@ -0,0 +1,73 @@
+package result
+
+import (
+	"errors"
+	"sync/atomic"
+)
+
+// ErrScopeEscaped is the panic value of Get when it is called after its Do
+// has returned. An Err met by Get on a goroutine other than the one running
+// Do panics there with an error that matches ErrScopeEscaped under errors.Is.
+var ErrScopeEscaped = errors.New("result: Get called after its Do returned")
+
+// Scope is the handle passed to the block of Do. It is only valid until Do
+// returns, and Get must be called on the goroutine that called Do; an Err
+// met on any other goroutine panics there instead of aborting Do.
+type Scope[E error] struct {
+	closed atomic.Bool
+}
+
+type scopeAbort[E error] struct {
+	scope *Scope[E]
+	err   E
+}
+
+// Error describes the abort for the case where nothing recovers it, which
+// only happens when Get runs outside the goroutine of its Do.
+func (a *scopeAbort[E]) Error() string {
+	return "result: Get called outside the goroutine running its Do"
+}
+
+func (a *scopeAbort[E]) Is(target error) bool {
+	return target == ErrScopeEscaped
+}
+
+func (a *scopeAbort[E]) abortsScope() {}
+
+// isScopeAbort reports whether a recovered value is a Get abort. Code that
+// recovers panics re-raises such values unchanged so the owning Do sees them.
+func isScopeAbort(v any) bool {
+	_, ok := v.(interface{ abortsScope() })
+	return ok
+}
+
+// Do runs f and returns its value as Ok. When Get inside f meets an Err,
+// f is aborted and that Err is returned instead, much like Rust's ? operator.
+// Panics not raised by Get for this scope propagate unchanged. TryRecover,
+// TryCtx and ParallelTraverse let the abort through to Do rather than turning
+// it into a *PanicError.
+func Do[T any, E error](f func(*Scope[E]) T) (r Result[T, E]) {
+	s := &Scope[E]{}
+	defer func() {
+		s.closed.Store(true)
+		if v := recover(); v != nil {
+			if a, ok := v.(*scopeAbort[E]); ok && a.scope == s {
+				r = Err[T](a.err)
+				return
+			}
+			panic(v)
+		}
+	}()
+	return Ok[T, E](f(s))
+}
+
+// Get returns the value of an Ok, or aborts the Do that owns s with r's Err.
+func Get[T any, E error](s *Scope[E], r Result[T, E]) T {
+	if r.ok {
+		return r.value
+	}
+	if s.closed.Load() {
+		panic(ErrScopeEscaped)
+	}
+	panic(&scopeAbort[E]{scope: s, err: r.err})
+}
@@ -0,0 +1,147 @@
+package result_test
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/magicdrive/maybe/result"
+)
+
+func parse(s string) result.Result[int, error] {
+	return result.From(strconv.Atoi(s))
+}
+
+func TestDo(t *testing.T) {
+	sum := result.Do(func(s *result.Scope[error]) int {
+		return result.Get(s, parse("1")) + result.Get(s, parse("2"))
+	})
+	if sum.Unwrap() != 3 {
+		t.Errorf("expected Ok(3), got %v", sum)
+	}
+
+	reached := false
+	failed := result.Do(func(s *result.Scope[error]) int {
+		a := result.Get(s, parse("1"))
+		b := result.Get(s, parse("x"))
+		reached = true
+		return a + b
+	})
+	if !failed.ErrIs(strconv.ErrSyntax) || reached {
+		t.Errorf("expected Do to abort with the parse error, got %v", failed)
+	}
+}
+
+func TestDoTypedError(t *testing.T) {
+	r := result.Do(func(s *result.Scope[*MyErr]) string {
+		result.Get(s, result.Err[int](&MyErr{msg: "typed"}))
+		return "unreachable"
+	})
+	if r.UnwrapErr().msg != "typed" {
+		t.Errorf("unexpected: %v", r)
+	}
+}
+
+func TestDoNested(t *testing.T) {
+	outer := result.Do(func(outer *result.Scope[error]) int {
+		inner := result.Do(func(inner *result.Scope[error]) int {
+			return result.Get(outer, parse("x"))
+		})
+		t.Errorf("expected outer scope to abort, inner returned %v", inner)
+		return 0
+	})
+	if outer.IsOk() {
+		t.Errorf("expected outer Err")
+	}
+
+	inner := result.Do(func(outer *result.Scope[error]) result.Result[int, error] {
+		return result.Do(func(inner *result.Scope[error]) int {
+			return result.Get(inner, parse("x"))
+		})
+	})
+	if inner.Unwrap().IsOk() {
+		t.Errorf("expected inner Err to stay in inner scope")
+	}
+}
+
+func TestDoPropagatesUnrelatedPanics(t *testing.T) {
+	boom := errors.New("boom")
+	defer func() {
+		if v := recover(); v != boom {
+			t.Errorf("expected original panic value, got %v", v)
+		}
+	}()
+	result.Do(func(s *result.Scope[error]) int {
+		panic(boom)
+	})
+}
+
+func TestDoGoroutineCannotAbortParent(t *testing.T) {
+	var recovered any
+	r := result.Do(func(s *result.Scope[error]) int {
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			defer func() { recovered = recover() }()
+			result.Get(s, parse("x"))
+		}()
+		<-done
+		return 1
+	})
+	if r.Unwrap() != 1 {
+		t.Errorf("expected parent scope to complete, got %v", r)
+	}
+	err, _ := recovered.(error)
+	if !errors.Is(err, result.ErrScopeEscaped) || err.Error() != "result: Get called outside the goroutine running its Do" {
+		t.Errorf("expected ErrScopeEscaped in goroutine, got %v", recovered)
+	}
+}
+
+func TestGetAfterDoReturned(t *testing.T) {
+	var leaked *result.Scope[error]
+	result.Do(func(s *result.Scope[error]) int {
+		leaked = s
+		return 0
+	})
+	defer func() {
+		if v := recover(); v != result.ErrScopeEscaped {
+			t.Errorf("expected ErrScopeEscaped, got %v", v)
+		}
+	}()
+	result.Get(leaked, parse("x"))
+}
+
+func TestDoAbortsThroughRecoveringHelpers(t *testing.T) {
+	r := result.Do(func(s *result.Scope[error]) int {
+		result.TryRecover(func() (int, error) {
+			return result.Get(s, parse("x")), nil
+		})
+		t.Errorf("expected TryRecover to let the abort through")
+		return 0
+	})
+	numErr, ok := result.ErrAs[*strconv.NumError](r)
+	if !ok {
+		t.Errorf("expected Do to end with the Get error, got %v", r)
+	}
+
+	r = result.Do(func(s *result.Scope[error]) int {
+		result.TryCtx(context.Background(), func(context.Context) (int, error) {
+			return result.Get(s, parse("y")), nil
+		}, identity)
+		return 0
+	})
+	if numErr, ok = result.ErrAs[*strconv.NumError](r); !ok || numErr.Num != "y" {
+		t.Errorf("expected TryCtx to let the abort through, got %v", r)
+	}
+
+	r = result.Do(func(s *result.Scope[error]) int {
+		result.ParallelTraverse(context.Background(), []string{"1", "z"}, func(_ context.Context, x string) result.Result[int, error] {
+			return result.Ok[int, error](result.Get(s, parse(x)))
+		})
+		return 0
+	})
+	if numErr, ok = result.ErrAs[*strconv.NumError](r); !ok || numErr.Num != "z" {
+		t.Errorf("expected ParallelTraverse to let the abort through, got %v", r)
+	}
+}
@@ -0,0 +1,70 @@
+package result
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+)
+
+func typeParams[T any, E error]() string {
+	return reflect.TypeFor[T]().String() + ", " + reflect.TypeFor[E]().String()
+}
+
+// String renders Ok(v) or Err(e).
+func (r Result[T, E]) String() string {
+	if r.ok {
+		return fmt.Sprintf("Ok(%v)", r.value)
+	}
+	return fmt.Sprintf("Err(%v)", r.err)
+}
+
+// GoString renders r as a Go expression such as result.Ok[int, error](42).
+func (r Result[T, E]) GoString() string {
+	if r.ok {
+		return fmt.Sprintf("result.Ok[%s](%#v)", typeParams[T, E](), r.value)
+	}
+	return fmt.Sprintf("result.Err[%s](%#v)", typeParams[T, E](), r.err)
+}
+
+// Format implements fmt.Formatter. %v renders Ok(v)/Err(e), %+v adds the
+// type parameters and, for Err, the full wrap chain of the error, and %#v
+// renders Go syntax. Other verbs apply to the contained value or error.
+func (r Result[T, E]) Format(f fmt.State, verb rune) {
+	switch {
+	case verb == 'v' && f.Flag('#'):
+		io.WriteString(f, r.GoString())
+	case verb == 'v' && f.Flag('+'):
+		if r.ok {
+			fmt.Fprintf(f, "Ok[%s](%+v)", typeParams[T, E](), r.value)
+			return
+		}
+		fmt.Fprintf(f, "Err[%s](%v)", typeParams[T, E](), r.err)
+		var b strings.Builder
+		writeErrorChain(&b, r.err, 1)
+		io.WriteString(f, b.String())
+	default:
+		if r.ok {
+			fmt.Fprintf(f, "Ok("+fmt.FormatString(f, verb)+")", r.value)
+			return
+		}
+		fmt.Fprintf(f, "Err("+fmt.FormatString(f, verb)+")", r.err)
+	}
+}
+
+func writeErrorChain(b *strings.Builder, err error, depth int) {
+	for err != nil {
+		fmt.Fprintf(b, "\n%s%T: %v", strings.Repeat("  ", depth), err, err)
+		switch x := err.(type) {
+		case interface{ Unwrap() error }:
+			err = x.Unwrap()
+		case interface{ Unwrap() []error }:
+			for _, e := range x.Unwrap() {
+				writeErrorChain(b, e, depth+1)
+			}
+			return
+		default:
+			return
+		}
+	}
+}
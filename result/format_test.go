@@ -0,0 +1,44 @@
+package result_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/magicdrive/maybe/result"
+)
+
+func TestResultFormat(t *testing.T) {
+	boom := errors.New("boom")
+	cases := []struct {
+		format string
+		value  any
+		want   string
+	}{
+		{"%v", result.Ok[int, error](42), "Ok(42)"},
+		{"%v", result.Err[int](boom), "Err(boom)"},
+		{"%q", result.Ok[string, error]("x"), `Ok("x")`},
+		{"%+v", result.Ok[int, error](42), "Ok[int, error](42)"},
+		{"%#v", result.Ok[int, error](42), "result.Ok[int, error](42)"},
+		{"%#v", result.Err[int](&MyErr{msg: "x"}), `result.Err[int, *result_test.MyErr](&result_test.MyErr{msg:"x"})`},
+	}
+	for _, c := range cases {
+		if got := fmt.Sprintf(c.format, c.value); got != c.want {
+			t.Errorf("%s: expected %q, got %q", c.format, c.want, got)
+		}
+	}
+}
+
+func TestResultFormatErrorChain(t *testing.T) {
+	root := errors.New("root")
+	wrapped := fmt.Errorf("load: %w", errors.Join(root, errors.New("other")))
+	got := fmt.Sprintf("%+v", result.Err[int](wrapped))
+	want := "Err[int, error](load: root\nother)\n" +
+		"  *fmt.wrapError: load: root\nother\n" +
+		"  *errors.joinError: root\nother\n" +
+		"    *errors.errorString: root\n" +
+		"    *errors.errorString: other"
+	if got != want {
+		t.Errorf("expected:\n%s\ngot:\n%s", want, got)
+	}
+}
@@ -0,0 +1,138 @@
+package result
+
+import (
+	"context"
+	"reflect"
+)
+
+// Future is the eventual Result of a function running in its own goroutine.
+type Future[T any, E error] struct {
+	done   chan struct{}
+	result Result[T, E]
+	wrap   func(error) E
+}
+
+// Go runs f in a new goroutine. A panic in f becomes an Err holding the
+// same *PanicError that TryRecover produces.
+func Go[T any](ctx context.Context, f func(context.Context) (T, error)) *Future[T, error] {
+	return Spawn(ctx, func(ctx context.Context) Result[T, error] {
+		return From(f(ctx))
+	}, func(e error) error { return e })
+}
+
+// Spawn runs f in a new goroutine. wrap converts panics and context errors
+// observed by Await into E.
+func Spawn[T any, E error](ctx context.Context, f func(context.Context) Result[T, E], wrap func(error) E) *Future[T, E] {
+	fut := &Future[T, E]{done: make(chan struct{}), wrap: wrap}
+	go func() {
+		defer close(fut.done)
+		defer func() {
+			if v := recover(); v != nil {
+				if isScopeAbort(v) {
+					panic(v)
+				}
+				fut.result = Err[T](wrap(newPanicError(v)))
+			}
+		}()
+		fut.result = f(ctx)
+	}()
+	return fut
+}
+
+// Done is closed once the result is available.
+func (f *Future[T, E]) Done() <-chan struct{} {
+	return f.done
+}
+
+// Await blocks until the result is available or ctx ends. In the latter case
+// it returns an Err wrapping ctx.Err(); the goroutine keeps running.
+func (f *Future[T, E]) Await(ctx context.Context) Result[T, E] {
+	select {
+	case <-f.done:
+		return f.result
+	case <-ctx.Done():
+		return Err[T](f.wrap(ctx.Err()))
+	}
+}
+
+// Poll returns the result without blocking. The bool is false while the
+// future is still running, so maybe.FromValue(f.Poll()) yields a Maybe.
+func (f *Future[T, E]) Poll() (Result[T, E], bool) {
+	select {
+	case <-f.done:
+		return f.result, true
+	default:
+		return Result[T, E]{}, false
+	}
+}
+
+// awaitEach calls yield with the index of each future as it completes,
+// stopping early when yield returns false. It returns ctx.Err() if ctx ends
+// before that.
+func awaitEach[T any, E error](ctx context.Context, futs []*Future[T, E], yield func(int) bool) error {
+	cases := make([]reflect.SelectCase, len(futs)+1)
+	for i, f := range futs {
+		cases[i] = reflect.SelectCase{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(f.done)}
+	}
+	cases[len(futs)] = reflect.SelectCase{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(ctx.Done())}
+	for range futs {
+		i, _, _ := reflect.Select(cases)
+		if i == len(futs) {
+			return ctx.Err()
+		}
+		if !yield(i) {
+			return nil
+		}
+		cases[i].Chan = reflect.Value{}
+	}
+	return nil
+}
+
+// AwaitAll waits for every future and returns their values in argument order.
+// It returns as soon as any future fails or ctx ends.
+func AwaitAll[T any, E error](ctx context.Context, futs ...*Future[T, E]) Result[[]T, E] {
+	var failed Result[T, E]
+	err := awaitEach(ctx, futs, func(i int) bool {
+		failed = futs[i].result
+		return failed.ok
+	})
+	if err != nil {
+		return Err[[]T](futs[0].wrap(err))
+	}
+	if len(futs) > 0 && !failed.ok {
+		return Err[[]T](failed.err)
+	}
+	values := make([]T, len(futs))
+	for i, f := range futs {
+		values[i] = f.result.value
+	}
+	return Ok[[]T, E](values)
+}
+
+// AwaitAny returns the first Ok among futs. When every future fails it
+// returns the Err that arrived last.
+func AwaitAny[T any, E error](ctx context.Context, futs ...*Future[T, E]) Result[T, E] {
+	if len(futs) == 0 {
+		panic("result: AwaitAny called with no futures")
+	}
+	var last Result[T, E]
+	err := awaitEach(ctx, futs, func(i int) bool {
+		last = futs[i].result
+		return !last.ok
+	})
+	if err != nil {
+		return Err[T](futs[0].wrap(err))
+	}
+	return last
+}
+
+// ThenAsync starts a future that awaits f and, when it is Ok, runs next with its value.
+func ThenAsync[T any, U any, E error](ctx context.Context, f *Future[T, E], next func(context.Context, T) Result[U, E]) *Future[U, E] {
+	return Spawn(ctx, func(ctx context.Context) Result[U, E] {
+		r := f.Await(ctx)
+		if !r.ok {
+			return Err[U](r.err)
+		}
+		return next(ctx, r.value)
+	}, f.wrap)
+}
@@ -0,0 +1,156 @@
+package result_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/magicdrive/maybe/result"
+)
+
+func TestFutureAwait(t *testing.T) {
+	ctx := context.Background()
+	f := result.Go(ctx, func(context.Context) (int, error) { return 42, nil })
+	if r := f.Await(ctx); r.Unwrap() != 42 {
+		t.Errorf("expected Ok(42), got %v", r)
+	}
+	<-f.Done()
+	if r, ok := f.Poll(); !ok || r.Unwrap() != 42 {
+		t.Errorf("expected Poll to return Ok(42) after Done")
+	}
+}
+
+func TestFuturePollPending(t *testing.T) {
+	release := make(chan struct{})
+	f := result.Go(context.Background(), func(context.Context) (int, error) {
+		<-release
+		return 1, nil
+	})
+	if _, ok := f.Poll(); ok {
+		t.Errorf("expected Poll to report pending")
+	}
+	close(release)
+	<-f.Done()
+	if _, ok := f.Poll(); !ok {
+		t.Errorf("expected Poll to report done")
+	}
+}
+
+func TestFutureAwaitContextCanceled(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+	f := result.Go(context.Background(), func(context.Context) (int, error) {
+		<-release
+		return 1, nil
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if r := f.Await(ctx); !errors.Is(r.UnwrapErr(), context.Canceled) {
+		t.Errorf("expected context.Canceled, got %v", r)
+	}
+}
+
+func TestFuturePanicBecomesErr(t *testing.T) {
+	f := result.Go(context.Background(), func(context.Context) (int, error) {
+		panic("kaboom")
+	})
+	r := f.Await(context.Background())
+	var pe *result.PanicError
+	if !errors.As(r.UnwrapErr(), &pe) {
+		t.Fatalf("expected *PanicError, got %v", r)
+	}
+	if pe.Value != "kaboom" || len(pe.Stack) == 0 {
+		t.Errorf("unexpected panic error: %v", pe)
+	}
+}
+
+func TestAwaitAll(t *testing.T) {
+	ctx := context.Background()
+	futs := []*result.Future[int, error]{
+		result.Go(ctx, func(context.Context) (int, error) {
+			time.Sleep(10 * time.Millisecond)
+			return 1, nil
+		}),
+		result.Go(ctx, func(context.Context) (int, error) { return 2, nil }),
+	}
+	r := result.AwaitAll(ctx, futs...)
+	if !reflect.DeepEqual(r.Unwrap(), []int{1, 2}) {
+		t.Errorf("expected Ok([1 2]) in argument order, got %v", r)
+	}
+
+	boom := errors.New("boom")
+	block := make(chan struct{})
+	defer close(block)
+	failed := result.AwaitAll(ctx,
+		result.Go(ctx, func(context.Context) (int, error) {
+			<-block
+			return 1, nil
+		}),
+		result.Go(ctx, func(context.Context) (int, error) { return 0, boom }),
+	)
+	if failed.UnwrapErr() != boom {
+		t.Errorf("expected AwaitAll to fail fast with boom, got %v", failed)
+	}
+}
+
+func TestAwaitAny(t *testing.T) {
+	ctx := context.Background()
+	block := make(chan struct{})
+	defer close(block)
+
+	r := result.AwaitAny(ctx,
+		result.Go(ctx, func(context.Context) (string, error) { return "", errors.New("fail") }),
+		result.Go(ctx, func(context.Context) (string, error) {
+			<-block
+			return "slow", nil
+		}),
+		result.Go(ctx, func(context.Context) (string, error) { return "fast", nil }),
+	)
+	if r.Unwrap() != "fast" {
+		t.Errorf("expected first success, got %v", r)
+	}
+
+	allFailed := result.AwaitAny(ctx,
+		result.Go(ctx, func(context.Context) (string, error) { return "", errors.New("a") }),
+		result.Go(ctx, func(context.Context) (string, error) { return "", errors.New("b") }),
+	)
+	if allFailed.IsOk() {
+		t.Errorf("expected Err when every future fails")
+	}
+}
+
+func TestThenAsync(t *testing.T) {
+	ctx := context.Background()
+	f := result.Go(ctx, func(context.Context) (int, error) { return 20, nil })
+	g := result.ThenAsync(ctx, f, func(_ context.Context, x int) result.Result[int, error] {
+		return result.Ok[int, error](x + 1)
+	})
+	if g.Await(ctx).Unwrap() != 21 {
+		t.Errorf("expected Ok(21)")
+	}
+
+	boom := errors.New("boom")
+	called := false
+	failed := result.ThenAsync(ctx,
+		result.Go(ctx, func(context.Context) (int, error) { return 0, boom }),
+		func(_ context.Context, x int) result.Result[int, error] {
+			called = true
+			return result.Ok[int, error](x)
+		})
+	if failed.Await(ctx).UnwrapErr() != boom || called {
+		t.Errorf("expected ThenAsync to propagate Err without calling next")
+	}
+}
+
+func TestSpawnWithTypedError(t *testing.T) {
+	ctx := context.Background()
+	f := result.Spawn(ctx, func(context.Context) result.Result[int, MyErr] {
+		panic("bad")
+	}, func(e error) MyErr { return MyErr{msg: e.Error()} })
+	if msg := f.Await(ctx).UnwrapErr().msg; msg != "panic: bad" {
+		t.Errorf("unexpected error: %s", msg)
+	}
+}
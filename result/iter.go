@@ -0,0 +1,45 @@
+package result
+
+import "iter"
+
+// All yields the Ok value once and nothing for Err.
+func (r Result[T, E]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if r.ok {
+			yield(r.value)
+		}
+	}
+}
+
+// FromSeq2 turns each (value, error) pair of seq into a Result.
+func FromSeq2[T any](seq iter.Seq2[T, error]) iter.Seq[Result[T, error]] {
+	return func(yield func(Result[T, error]) bool) {
+		for v, err := range seq {
+			if !yield(From(v, err)) {
+				return
+			}
+		}
+	}
+}
+
+// OksSeq yields the value of every Ok in seq and skips Err.
+func OksSeq[T any, E error](seq iter.Seq[Result[T, E]]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for r := range seq {
+			if r.ok && !yield(r.value) {
+				return
+			}
+		}
+	}
+}
+
+// ErrsSeq yields the error of every Err in seq and skips Ok.
+func ErrsSeq[T any, E error](seq iter.Seq[Result[T, E]]) iter.Seq[E] {
+	return func(yield func(E) bool) {
+		for r := range seq {
+			if !r.ok && !yield(r.err) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package result_test
+
+import (
+	"errors"
+	"iter"
+	"reflect"
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/magicdrive/maybe/result"
+)
+
+func parseAll(ss []string) iter.Seq2[int, error] {
+	return func(yield func(int, error) bool) {
+		for _, s := range ss {
+			if !yield(strconv.Atoi(s)) {
+				return
+			}
+		}
+	}
+}
+
+func TestResultAll(t *testing.T) {
+	if got := slices.Collect(result.Ok[int, error](1).All()); !reflect.DeepEqual(got, []int{1}) {
+		t.Errorf("expected [1], got %v", got)
+	}
+	if got := slices.Collect(result.Err[int](errors.New("x")).All()); len(got) != 0 {
+		t.Errorf("expected no elements, got %v", got)
+	}
+}
+
+func TestFromSeq2AndOksErrs(t *testing.T) {
+	rs := slices.Collect(result.FromSeq2(parseAll([]string{"1", "x", "3", "y"})))
+	if len(rs) != 4 || rs[1].IsOk() {
+		t.Fatalf("unexpected results: %v", rs)
+	}
+
+	oks := slices.Collect(result.OksSeq(slices.Values(rs)))
+	if !reflect.DeepEqual(oks, []int{1, 3}) {
+		t.Errorf("expected [1 3], got %v", oks)
+	}
+
+	errs := slices.Collect(result.ErrsSeq(slices.Values(rs)))
+	if len(errs) != 2 {
+		t.Errorf("expected 2 errors, got %v", errs)
+	}
+}
+
+func TestFromSeq2StopsEarly(t *testing.T) {
+	var n int
+	for r := range result.FromSeq2(parseAll([]string{"1", "2", "3"})) {
+		n++
+		if r.Unwrap() == 2 {
+			break
+		}
+	}
+	if n != 2 {
+		t.Errorf("expected to stop after 2 elements, got %d", n)
+	}
+}
@@ -0,0 +1,191 @@
+package result
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+// ErrorCodec converts a concrete error type to and from JSON so that
+// Err values survive a round trip through Result's JSON envelope.
+type ErrorCodec[E error] struct {
+	Encode func(E) ([]byte, error)
+	Decode func([]byte) (E, error)
+}
+
+// RemoteError is decoded from an error envelope whose type has no registered codec.
+type RemoteError struct {
+	Type    string `json:"type,omitempty"`
+	Message string `json:"message"`
+}
+
+func (e *RemoteError) Error() string {
+	return e.Message
+}
+
+type errorCodecEntry struct {
+	name   string
+	encode func(error) ([]byte, error)
+	decode func([]byte) (error, error)
+}
+
+var errorCodecs = struct {
+	sync.RWMutex
+	byName map[string]errorCodecEntry
+	byType map[reflect.Type]errorCodecEntry
+}{
+	byName: map[string]errorCodecEntry{},
+	byType: map[reflect.Type]errorCodecEntry{},
+}
+
+// RegisterErrorCodec registers codec for E under name. Registering the same
+// name or type again replaces the previous codec.
+func RegisterErrorCodec[E error](name string, codec ErrorCodec[E]) {
+	entry := errorCodecEntry{
+		name: name,
+		encode: func(err error) ([]byte, error) {
+			return codec.Encode(err.(E))
+		},
+		decode: func(data []byte) (error, error) {
+			return codec.Decode(data)
+		},
+	}
+	t := reflect.TypeFor[E]()
+
+	errorCodecs.Lock()
+	defer errorCodecs.Unlock()
+	errorCodecs.byName[name] = entry
+	errorCodecs.byType[t] = entry
+}
+
+// RegisterError registers E under name using encoding/json for its payload.
+func RegisterError[E error](name string) {
+	RegisterErrorCodec(name, ErrorCodec[E]{
+		Encode: func(e E) ([]byte, error) {
+			return json.Marshal(e)
+		},
+		Decode: func(data []byte) (E, error) {
+			var e E
+			if t := reflect.TypeFor[E](); t.Kind() == reflect.Pointer {
+				e = reflect.New(t.Elem()).Interface().(E)
+				return e, json.Unmarshal(data, e)
+			}
+			err := json.Unmarshal(data, &e)
+			return e, err
+		},
+	})
+}
+
+func lookupErrorCodecByType(t reflect.Type) (errorCodecEntry, bool) {
+	errorCodecs.RLock()
+	defer errorCodecs.RUnlock()
+	entry, ok := errorCodecs.byType[t]
+	return entry, ok
+}
+
+// findErrorCodec returns the first error in err's Unwrap chain whose type has
+// a registered codec, so that wrapping a registered error keeps its payload.
+func findErrorCodec(err error) (error, errorCodecEntry, bool) {
+	for ; err != nil; err = errors.Unwrap(err) {
+		if entry, ok := lookupErrorCodecByType(reflect.TypeOf(err)); ok {
+			return err, entry, true
+		}
+	}
+	return nil, errorCodecEntry{}, false
+}
+
+func lookupErrorCodecByName(name string) (errorCodecEntry, bool) {
+	errorCodecs.RLock()
+	defer errorCodecs.RUnlock()
+	entry, ok := errorCodecs.byName[name]
+	return entry, ok
+}
+
+type errEnvelope struct {
+	Type    string          `json:"type,omitempty"`
+	Message string          `json:"message"`
+	Data    json.RawMessage `json:"data,omitempty"`
+}
+
+// MarshalJSON encodes Ok(v) as {"ok": v} and Err(e) as
+// {"err": {"type": ..., "message": ..., "data": ...}}, where data comes from
+// the first error in e's Unwrap chain with a registered codec. Errors without
+// one are encoded as {"err": {"message": ...}}.
+func (r Result[T, E]) MarshalJSON() ([]byte, error) {
+	if r.ok {
+		return json.Marshal(struct {
+			Ok T `json:"ok"`
+		}{r.value})
+	}
+
+	var env errEnvelope
+	if err := error(r.err); !isNilError(err) {
+		env.Message = err.Error()
+		var remote *RemoteError
+		if typed, entry, ok := findErrorCodec(err); ok {
+			data, encErr := entry.encode(typed)
+			if encErr != nil {
+				return nil, fmt.Errorf("result: encode error %q: %w", entry.name, encErr)
+			}
+			env.Type = entry.name
+			env.Data = data
+		} else if errors.As(err, &remote) {
+			env.Type = remote.Type
+		}
+	}
+	return json.Marshal(struct {
+		Err errEnvelope `json:"err"`
+	}{env})
+}
+
+// isNilError reports whether err is nil or a nil pointer boxed in an
+// interface, as found in the zero value of a Result with a pointer E.
+func isNilError(err error) bool {
+	if err == nil {
+		return true
+	}
+	rv := reflect.ValueOf(err)
+	return rv.Kind() == reflect.Pointer && rv.IsNil()
+}
+
+func (r *Result[T, E]) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	okData, hasOk := fields["ok"]
+	errData, hasErr := fields["err"]
+	if hasOk == hasErr {
+		return errors.New(`result: JSON must contain exactly one of "ok" or "err"`)
+	}
+
+	if hasOk {
+		var v T
+		if err := json.Unmarshal(okData, &v); err != nil {
+			return err
+		}
+		*r = Ok[T, E](v)
+		return nil
+	}
+
+	var env errEnvelope
+	if err := json.Unmarshal(errData, &env); err != nil {
+		return err
+	}
+	var decoded error = &RemoteError{Type: env.Type, Message: env.Message}
+	if entry, ok := lookupErrorCodecByName(env.Type); ok && env.Type != "" {
+		d, err := entry.decode(env.Data)
+		if err != nil {
+			return fmt.Errorf("result: decode error %q: %w", env.Type, err)
+		}
+		decoded = d
+	}
+	e, ok := decoded.(E)
+	if !ok {
+		return fmt.Errorf("result: cannot decode error %T into %s", decoded, reflect.TypeFor[E]())
+	}
+	*r = Err[T](e)
+	return nil
+}
@@ -0,0 +1,137 @@
+package result_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/magicdrive/maybe/result"
+)
+
+type NotFoundError struct {
+	Resource string `json:"resource"`
+	ID       int    `json:"id"`
+}
+
+func (e *NotFoundError) Error() string {
+	return "not found: " + e.Resource
+}
+
+func init() {
+	result.RegisterError[*NotFoundError]("not_found")
+}
+
+func TestResultMarshalJSON(t *testing.T) {
+	b, err := json.Marshal(result.Ok[int, error](42))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != `{"ok":42}` {
+		t.Errorf("unexpected JSON: %s", b)
+	}
+
+	b, err = json.Marshal(result.Err[int, error](errors.New("boom")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != `{"err":{"message":"boom"}}` {
+		t.Errorf("unexpected JSON: %s", b)
+	}
+
+	b, err = json.Marshal(result.Err[int, error](&NotFoundError{Resource: "user", ID: 3}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"err":{"type":"not_found","message":"not found: user","data":{"resource":"user","id":3}}}`
+	if string(b) != want {
+		t.Errorf("expected %s, got %s", want, b)
+	}
+}
+
+func TestResultUnmarshalJSON(t *testing.T) {
+	var r result.Result[string, error]
+	if err := json.Unmarshal([]byte(`{"ok":"hi"}`), &r); err != nil {
+		t.Fatal(err)
+	}
+	if r.Unwrap() != "hi" {
+		t.Errorf("expected Ok(hi)")
+	}
+
+	if err := json.Unmarshal([]byte(`{"err":{"message":"boom"}}`), &r); err != nil {
+		t.Fatal(err)
+	}
+	var remote *result.RemoteError
+	if !errors.As(r.UnwrapErr(), &remote) || remote.Message != "boom" {
+		t.Errorf("expected RemoteError(boom), got %v", r.UnwrapErr())
+	}
+
+	if err := json.Unmarshal([]byte(`{}`), &r); err == nil {
+		t.Errorf("expected error for empty envelope")
+	}
+	if err := json.Unmarshal([]byte(`{"ok":1,"err":{"message":"x"}}`), &r); err == nil {
+		t.Errorf("expected error for ambiguous envelope")
+	}
+}
+
+func TestResultJSONTypedErrorRoundTrip(t *testing.T) {
+	in := result.Err[int](&NotFoundError{Resource: "user", ID: 3})
+	b, err := json.Marshal(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var out result.Result[int, *NotFoundError]
+	if err := json.Unmarshal(b, &out); err != nil {
+		t.Fatal(err)
+	}
+	if e := out.UnwrapErr(); e.Resource != "user" || e.ID != 3 {
+		t.Errorf("unexpected decoded error: %+v", e)
+	}
+
+	var asErr result.Result[int, error]
+	if err := json.Unmarshal(b, &asErr); err != nil {
+		t.Fatal(err)
+	}
+	var nf *NotFoundError
+	if !errors.As(asErr.UnwrapErr(), &nf) {
+		t.Errorf("expected *NotFoundError, got %T", asErr.UnwrapErr())
+	}
+
+	var mismatch result.Result[int, *NotFoundError]
+	if err := json.Unmarshal([]byte(`{"err":{"message":"boom"}}`), &mismatch); err == nil {
+		t.Errorf("expected error decoding unknown error into concrete type")
+	}
+}
+
+func TestResultMarshalJSONZeroPointerError(t *testing.T) {
+	var dto struct {
+		R result.Result[int, *NotFoundError] `json:"r"`
+	}
+	b, err := json.Marshal(dto)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"r":{"err":{"message":""}}}`; string(b) != want {
+		t.Errorf("expected %s, got %s", want, b)
+	}
+}
+
+func TestResultJSONWrappedTypedError(t *testing.T) {
+	in := result.Context(result.Err[int](&NotFoundError{Resource: "user", ID: 3}), "loading %s", "profile")
+	b, err := json.Marshal(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"err":{"type":"not_found","message":"loading profile: not found: user","data":{"resource":"user","id":3}}}`
+	if string(b) != want {
+		t.Errorf("expected %s, got %s", want, b)
+	}
+
+	var out result.Result[int, *NotFoundError]
+	if err := json.Unmarshal(b, &out); err != nil {
+		t.Fatal(err)
+	}
+	if e := out.UnwrapErr(); e.Resource != "user" || e.ID != 3 {
+		t.Errorf("unexpected decoded error: %+v", e)
+	}
+}
@@ -0,0 +1,78 @@
+package result_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/magicdrive/maybe/result"
+)
+
+type RepoError struct{ Table string }
+
+func (e *RepoError) Error() string { return "repo: " + e.Table }
+
+type ServiceError struct {
+	Op    string
+	Cause error
+}
+
+func (e *ServiceError) Error() string { return e.Op + ": " + e.Cause.Error() }
+func (e *ServiceError) Unwrap() error { return e.Cause }
+
+func toServiceError(op string) func(*RepoError) *ServiceError {
+	return func(e *RepoError) *ServiceError { return &ServiceError{Op: op, Cause: e} }
+}
+
+func TestMapErr(t *testing.T) {
+	repoErr := &RepoError{Table: "users"}
+	r := result.MapErr(result.Err[int](repoErr), toServiceError("load user"))
+	if e := r.UnwrapErr(); e.Op != "load user" || e.Cause != repoErr {
+		t.Errorf("unexpected mapped error: %v", e)
+	}
+
+	ok := result.MapErr(result.Ok[int, *RepoError](1), toServiceError("x"))
+	if ok.Unwrap() != 1 {
+		t.Errorf("expected Ok unchanged")
+	}
+}
+
+func TestAndThenWith(t *testing.T) {
+	render := func(id int) result.Result[string, *ServiceError] {
+		return result.Ok[string, *ServiceError](fmt.Sprint("user ", id))
+	}
+
+	got := result.AndThenWith(result.Ok[int, *RepoError](7), render, toServiceError("render"))
+	if got.Unwrap() != "user 7" {
+		t.Errorf("unexpected: %v", got)
+	}
+
+	failed := result.AndThenWith(result.Err[int](&RepoError{Table: "users"}), render, toServiceError("render"))
+	if failed.UnwrapErr().Error() != "render: repo: users" {
+		t.Errorf("unexpected: %v", failed)
+	}
+}
+
+func TestWidenNarrow(t *testing.T) {
+	repoErr := &RepoError{Table: "orders"}
+	wide := result.Widen(result.Err[int](repoErr))
+	if !errors.Is(wide.UnwrapErr(), repoErr) {
+		t.Errorf("expected widened error to keep identity")
+	}
+	if result.Widen(result.Ok[int, *RepoError](3)).Unwrap() != 3 {
+		t.Errorf("expected Ok unchanged")
+	}
+
+	wrapped := result.Err[int](fmt.Errorf("layer: %w", repoErr))
+	narrow, ok := result.Narrow[*RepoError](wrapped)
+	if !ok || narrow.UnwrapErr() != repoErr {
+		t.Errorf("expected to narrow to *RepoError")
+	}
+
+	if _, ok := result.Narrow[*ServiceError](wrapped); ok {
+		t.Errorf("expected narrowing to unrelated type to fail")
+	}
+	if r, ok := result.Narrow[*ServiceError](result.Ok[int, error](1)); !ok || r.Unwrap() != 1 {
+		t.Errorf("expected Ok to narrow trivially")
+	}
+}
@@ -0,0 +1,69 @@
+package result
+
+import "errors"
+
+// ErrIs reports whether r is an Err whose error chain matches target.
+func (r Result[T, E]) ErrIs(target error) bool {
+	return !r.ok && errors.Is(r.err, target)
+}
+
+// ErrAs finds the first error in the chain of an Err that matches Target,
+// following the rules of errors.As. The (Target, bool) pair can be turned
+// into a Maybe with maybe.FromValue.
+func ErrAs[Target any, T any, E error](r Result[T, E]) (Target, bool) {
+	var target Target
+	if r.ok {
+		return target, false
+	}
+	return target, errors.As(r.err, &target)
+}
+
+type MatchErrCase struct {
+	Cond func(error) bool
+	Then func(error)
+}
+
+// IsCase matches errors for which errors.Is(err, target) holds.
+func IsCase(target error, then func(error)) MatchErrCase {
+	return MatchErrCase{
+		Cond: func(err error) bool { return errors.Is(err, target) },
+		Then: then,
+	}
+}
+
+// AsCase matches errors whose chain contains a Target and passes it to then.
+func AsCase[Target any](then func(Target)) MatchErrCase {
+	return MatchErrCase{
+		Cond: func(err error) bool {
+			var target Target
+			return errors.As(err, &target)
+		},
+		Then: func(err error) {
+			var target Target
+			errors.As(err, &target)
+			then(target)
+		},
+	}
+}
+
+// MatchErr calls okFn for Ok. For Err it runs the first case whose Cond
+// matches the error, searching wrapped and joined errors, or elseFn when
+// none does.
+func MatchErr[T any, E error](
+	r Result[T, E],
+	okFn func(T),
+	cases []MatchErrCase,
+	elseFn func(E),
+) {
+	if r.ok {
+		okFn(r.value)
+		return
+	}
+	for _, c := range cases {
+		if c.Cond(r.err) {
+			c.Then(r.err)
+			return
+		}
+	}
+	elseFn(r.err)
+}
@@ -0,0 +1,70 @@
+package result_test
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"testing"
+
+	"github.com/magicdrive/maybe/result"
+)
+
+func TestErrIs(t *testing.T) {
+	r := result.Err[int](fmt.Errorf("open: %w", fs.ErrNotExist))
+	if !r.ErrIs(fs.ErrNotExist) || r.ErrIs(fs.ErrPermission) {
+		t.Errorf("unexpected ErrIs result")
+	}
+	if result.Ok[int, error](1).ErrIs(fs.ErrNotExist) {
+		t.Errorf("expected Ok not to match")
+	}
+}
+
+func TestErrAs(t *testing.T) {
+	nf := &NotFoundError{Resource: "user"}
+	r := result.Err[int](errors.Join(errors.New("other"), fmt.Errorf("lookup: %w", nf)))
+
+	got, ok := result.ErrAs[*NotFoundError](r)
+	if !ok || got != nf {
+		t.Errorf("expected to find *NotFoundError in joined chain")
+	}
+	if _, ok := result.ErrAs[*MyErr](r); ok {
+		t.Errorf("expected no *MyErr")
+	}
+	if _, ok := result.ErrAs[*NotFoundError](result.Ok[int, error](1)); ok {
+		t.Errorf("expected Ok to yield nothing")
+	}
+}
+
+func TestMatchErr(t *testing.T) {
+	cases := func(called *string) []result.MatchErrCase {
+		return []result.MatchErrCase{
+			result.IsCase(fs.ErrPermission, func(error) { *called = "permission" }),
+			result.AsCase(func(e *NotFoundError) { *called = "not found: " + e.Resource }),
+			result.IsCase(fs.ErrNotExist, func(error) { *called = "not exist" }),
+		}
+	}
+	run := func(r result.Result[int, error]) string {
+		var called string
+		result.MatchErr(r, func(v int) {
+			called = fmt.Sprint("ok ", v)
+		}, cases(&called), func(e error) {
+			called = "else: " + e.Error()
+		})
+		return called
+	}
+
+	if got := run(result.Ok[int, error](1)); got != "ok 1" {
+		t.Errorf("unexpected: %s", got)
+	}
+	wrapped := fmt.Errorf("svc: %w", &NotFoundError{Resource: "user"})
+	if got := run(result.Err[int](wrapped)); got != "not found: user" {
+		t.Errorf("unexpected: %s", got)
+	}
+	joined := errors.Join(fs.ErrNotExist, fs.ErrPermission)
+	if got := run(result.Err[int](joined)); got != "permission" {
+		t.Errorf("expected first matching case in order, got %s", got)
+	}
+	if got := run(result.Err[int](errors.New("boom"))); got != "else: boom" {
+		t.Errorf("unexpected: %s", got)
+	}
+}
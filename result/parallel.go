@@ -0,0 +1,127 @@
+package result
+
+import (
+	"context"
+	"runtime"
+	"sync"
+	"time"
+)
+
+type parallelConfig struct {
+	concurrency int
+	failFast    bool
+	itemTimeout time.Duration
+	progress    func(done, total int)
+}
+
+// ParallelOption configures ParallelTraverse.
+type ParallelOption func(*parallelConfig)
+
+// WithConcurrency limits how many items run at once. The default is GOMAXPROCS.
+func WithConcurrency(n int) ParallelOption {
+	return func(c *parallelConfig) {
+		c.concurrency = n
+	}
+}
+
+// WithFailFast cancels the context passed to the remaining items at the first
+// Err. Items that have not started yet are still called, with the canceled
+// context, since there is no E to fill their slots with otherwise.
+func WithFailFast() ParallelOption {
+	return func(c *parallelConfig) {
+		c.failFast = true
+	}
+}
+
+// WithItemTimeout gives every item its own context deadline.
+func WithItemTimeout(d time.Duration) ParallelOption {
+	return func(c *parallelConfig) {
+		c.itemTimeout = d
+	}
+}
+
+// WithProgress calls fn after every finished item. Calls are serialized.
+func WithProgress(fn func(done, total int)) ParallelOption {
+	return func(c *parallelConfig) {
+		c.progress = fn
+	}
+}
+
+// ParallelTraverse applies f to every element of xs on a bounded pool of
+// goroutines and returns the results in input order. In fail-fast mode f
+// should check ctx before doing work; see WithFailFast. A panic in f
+// is re-raised in the caller as a *PanicError once all workers stop; a Get
+// abort is re-raised unchanged so that it ends the caller's Do.
+func ParallelTraverse[A any, B any, E error](ctx context.Context, xs []A, f func(context.Context, A) Result[B, E], opts ...ParallelOption) []Result[B, E] {
+	cfg := parallelConfig{concurrency: runtime.GOMAXPROCS(0)}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	workers := min(max(cfg.concurrency, 1), len(xs))
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	results := make([]Result[B, E], len(xs))
+	jobs := make(chan int)
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		done     int
+		panicked any
+	)
+	run := func(i int) {
+		defer func() {
+			if v := recover(); v != nil {
+				mu.Lock()
+				if panicked == nil && isScopeAbort(v) {
+					panicked = v
+				} else if panicked == nil {
+					panicked = newPanicError(v)
+				}
+				mu.Unlock()
+				cancel()
+			}
+		}()
+
+		itemCtx := ctx
+		if cfg.itemTimeout > 0 {
+			var itemCancel context.CancelFunc
+			itemCtx, itemCancel = context.WithTimeout(ctx, cfg.itemTimeout)
+			defer itemCancel()
+		}
+		r := f(itemCtx, xs[i])
+		results[i] = r
+		if !r.ok && cfg.failFast {
+			cancel()
+		}
+
+		if cfg.progress != nil {
+			mu.Lock()
+			done++
+			cfg.progress(done, len(xs))
+			mu.Unlock()
+		}
+	}
+
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				run(i)
+			}
+		}()
+	}
+	for i := range xs {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	if panicked != nil {
+		panic(panicked)
+	}
+	return results
+}
@@ -0,0 +1,117 @@
+package result_test
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/magicdrive/maybe/result"
+)
+
+func TestParallelTraverseOrderAndConcurrency(t *testing.T) {
+	var running, peak atomic.Int32
+	xs := []int{5, 1, 4, 2, 3, 0}
+
+	rs := result.ParallelTraverse(context.Background(), xs,
+		func(_ context.Context, x int) result.Result[string, error] {
+			n := running.Add(1)
+			for {
+				p := peak.Load()
+				if n <= p || peak.CompareAndSwap(p, n) {
+					break
+				}
+			}
+			time.Sleep(time.Duration(x) * time.Millisecond)
+			running.Add(-1)
+			return result.Ok[string, error](strconv.Itoa(x))
+		},
+		result.WithConcurrency(2),
+	)
+
+	for i, r := range rs {
+		if r.Unwrap() != strconv.Itoa(xs[i]) {
+			t.Errorf("index %d: expected %d, got %v", i, xs[i], r)
+		}
+	}
+	if peak.Load() > 2 {
+		t.Errorf("expected at most 2 concurrent items, saw %d", peak.Load())
+	}
+}
+
+func TestParallelTraverseFailFast(t *testing.T) {
+	boom := errors.New("boom")
+	xs := []int{0, 1, 2, 3, 4, 5, 6, 7}
+
+	rs := result.ParallelTraverse(context.Background(), xs,
+		func(ctx context.Context, x int) result.Result[int, error] {
+			if x == 0 {
+				return result.Err[int](boom)
+			}
+			if err := ctx.Err(); err != nil {
+				return result.Err[int](err)
+			}
+			return result.Ok[int, error](x)
+		},
+		result.WithConcurrency(1),
+		result.WithFailFast(),
+	)
+
+	if rs[0].UnwrapErr() != boom {
+		t.Errorf("expected first item to fail with boom")
+	}
+	for i, r := range rs[1:] {
+		if !errors.Is(r.UnwrapErr(), context.Canceled) {
+			t.Errorf("index %d: expected canceled, got %v", i+1, r)
+		}
+	}
+}
+
+func TestParallelTraverseItemTimeoutAndProgress(t *testing.T) {
+	var calls []int
+	rs := result.ParallelTraverse(context.Background(), []int{1, 2, 3},
+		func(ctx context.Context, x int) result.Result[int, error] {
+			if x == 2 {
+				<-ctx.Done()
+				return result.Err[int](ctx.Err())
+			}
+			return result.Ok[int, error](x)
+		},
+		result.WithItemTimeout(10*time.Millisecond),
+		result.WithProgress(func(done, total int) {
+			if total != 3 {
+				t.Errorf("expected total 3, got %d", total)
+			}
+			calls = append(calls, done)
+		}),
+	)
+
+	if !errors.Is(rs[1].UnwrapErr(), context.DeadlineExceeded) {
+		t.Errorf("expected deadline exceeded, got %v", rs[1])
+	}
+	if rs[0].Unwrap() != 1 || rs[2].Unwrap() != 3 {
+		t.Errorf("expected other items to succeed")
+	}
+	if len(calls) != 3 || calls[2] != 3 {
+		t.Errorf("unexpected progress calls: %v", calls)
+	}
+}
+
+func TestParallelTraversePanic(t *testing.T) {
+	defer func() {
+		var pe *result.PanicError
+		if err, ok := recover().(error); !ok || !errors.As(err, &pe) || pe.Value != "bad item" {
+			t.Errorf("expected *PanicError to be re-raised")
+		}
+	}()
+	result.ParallelTraverse(context.Background(), []int{1, 2},
+		func(_ context.Context, x int) result.Result[int, error] {
+			if x == 2 {
+				panic("bad item")
+			}
+			return result.Ok[int, error](x)
+		})
+	t.Errorf("expected panic")
+}
@@ -1,5 +1,18 @@
 package result
 
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrUnwrapOnErr is wrapped, together with the contained error, by the
+	// panic value of Unwrap and Expect on Err.
+	ErrUnwrapOnErr = errors.New("called Unwrap on Err")
+	// ErrUnwrapErrOnOk is wrapped by the panic value of UnwrapErr and ExpectErr on Ok.
+	ErrUnwrapErrOnOk = errors.New("called UnwrapErr on Ok")
+)
+
 type Result[T any, E error] struct {
 	value T
 	err   E
@@ -39,11 +52,26 @@ func (r Result[T, E]) IsErr() bool {
 
 func (r Result[T, E]) Unwrap() T {
 	if !r.ok {
-		panic("called Unwrap on Err")
+		panic(r.unwrapPanic(""))
 	}
 	return r.value
 }
 
+// Expect is like Unwrap but prefixes the panic value with msg.
+func (r Result[T, E]) Expect(msg string) T {
+	if !r.ok {
+		panic(r.unwrapPanic(msg + ": "))
+	}
+	return r.value
+}
+
+func (r Result[T, E]) unwrapPanic(prefix string) error {
+	if any(r.err) == nil {
+		return fmt.Errorf("%s%w", prefix, ErrUnwrapOnErr)
+	}
+	return fmt.Errorf("%s%w: %w", prefix, ErrUnwrapOnErr, r.err)
+}
+
 func (r Result[T, E]) UnwrapOr(def T) T {
 	if r.ok {
 		return r.value
@@ -51,9 +79,26 @@ func (r Result[T, E]) UnwrapOr(def T) T {
 	return def
 }
 
+// Get returns the value and error of r as an idiomatic (T, error) pair.
+func (r Result[T, E]) Get() (T, error) {
+	if r.ok {
+		return r.value, nil
+	}
+	var zero T
+	return zero, r.err
+}
+
 func (r Result[T, E]) UnwrapErr() E {
 	if r.ok {
-		panic("called UnwrapErr on Ok")
+		panic(fmt.Errorf("%w: %v", ErrUnwrapErrOnOk, r.value))
+	}
+	return r.err
+}
+
+// ExpectErr is like UnwrapErr but prefixes the panic value with msg.
+func (r Result[T, E]) ExpectErr(msg string) E {
+	if r.ok {
+		panic(fmt.Errorf("%s: %w: %v", msg, ErrUnwrapErrOnOk, r.value))
 	}
 	return r.err
 }
@@ -79,6 +124,43 @@ func AndThen[T any, E error, U any](r Result[T, E], f func(T) Result[U, E]) Resu
 	return Result[U, E]{err: r.err, ok: false}
 }
 
+func MapErr[T any, E error, E2 error](r Result[T, E], f func(E) E2) Result[T, E2] {
+	if r.ok {
+		return Ok[T, E2](r.value)
+	}
+	return Err[T](f(r.err))
+}
+
+// AndThenWith is like AndThen but the continuation may use another error
+// type; adapt converts an Err of r into it.
+func AndThenWith[T any, E error, U any, E2 error](r Result[T, E], f func(T) Result[U, E2], adapt func(E) E2) Result[U, E2] {
+	if r.ok {
+		return f(r.value)
+	}
+	return Err[U](adapt(r.err))
+}
+
+// Widen erases the concrete error type of r.
+func Widen[T any, E error](r Result[T, E]) Result[T, error] {
+	if r.ok {
+		return Ok[T, error](r.value)
+	}
+	return Err[T, error](r.err)
+}
+
+// Narrow converts r to a concrete error type using errors.As. The bool is
+// false when r is an Err whose chain has no E2.
+func Narrow[E2 error, T any](r Result[T, error]) (Result[T, E2], bool) {
+	if r.ok {
+		return Ok[T, E2](r.value), true
+	}
+	var e E2
+	if !errors.As(r.err, &e) {
+		return Result[T, E2]{}, false
+	}
+	return Err[T](e), true
+}
+
 func (r Result[T, E]) Match(okFn func(T), errFn func(E)) {
 	if r.ok {
 		okFn(r.value)
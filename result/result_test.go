@@ -148,3 +148,53 @@ func TestResultTap(t *testing.T) {
 		t.Errorf("expected Err unchanged")
 	}
 }
+
+func recoverError(f func()) (err error) {
+	defer func() {
+		err, _ = recover().(error)
+	}()
+	f()
+	return nil
+}
+
+func TestUnwrapPanicWrapsContainedError(t *testing.T) {
+	root := &MyErr{msg: "disk full"}
+	err := recoverError(func() { result.Err[int](root).Unwrap() })
+	if !errors.Is(err, result.ErrUnwrapOnErr) {
+		t.Errorf("expected ErrUnwrapOnErr, got %v", err)
+	}
+	var myErr *MyErr
+	if !errors.As(err, &myErr) || myErr != root {
+		t.Errorf("expected panic value to wrap the contained error")
+	}
+	if err.Error() != "called Unwrap on Err: disk full" {
+		t.Errorf("unexpected message: %s", err.Error())
+	}
+
+	err = recoverError(func() { result.Err[int, error](nil).Unwrap() })
+	if err == nil || err.Error() != "called Unwrap on Err" {
+		t.Errorf("unexpected panic value for nil error: %v", err)
+	}
+}
+
+func TestExpectAndExpectErr(t *testing.T) {
+	if result.Ok[int, error](1).Expect("load") != 1 {
+		t.Errorf("expected 1")
+	}
+	root := errors.New("timeout")
+	err := recoverError(func() { result.Err[int](root).Expect("loading config") })
+	if !errors.Is(err, result.ErrUnwrapOnErr) || !errors.Is(err, root) {
+		t.Errorf("expected panic to wrap both sentinel and root cause, got %v", err)
+	}
+	if err.Error() != "loading config: called Unwrap on Err: timeout" {
+		t.Errorf("unexpected message: %s", err.Error())
+	}
+
+	if result.Err[int](root).ExpectErr("want failure") != root {
+		t.Errorf("expected contained error")
+	}
+	err = recoverError(func() { result.Ok[int, error](5).ExpectErr("want failure") })
+	if !errors.Is(err, result.ErrUnwrapErrOnOk) || err.Error() != "want failure: called UnwrapErr on Ok: 5" {
+		t.Errorf("unexpected panic value: %v", err)
+	}
+}
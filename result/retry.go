@@ -0,0 +1,232 @@
+package result
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"time"
+)
+
+// Policy decides whether and how long to wait before the next attempt.
+// attempt is the number of attempts made so far, starting at 1.
+type Policy interface {
+	Next(attempt int, elapsed time.Duration) (time.Duration, bool)
+}
+
+type PolicyFunc func(attempt int, elapsed time.Duration) (time.Duration, bool)
+
+func (f PolicyFunc) Next(attempt int, elapsed time.Duration) (time.Duration, bool) {
+	return f(attempt, elapsed)
+}
+
+// Constant waits d between attempts and never gives up on its own.
+func Constant(d time.Duration) Policy {
+	return PolicyFunc(func(int, time.Duration) (time.Duration, bool) {
+		return d, true
+	})
+}
+
+// Exponential doubles the delay after every attempt starting at base, capped
+// at limit when limit > 0. jitter in [0, 1] randomly shortens each delay by
+// up to that fraction.
+func Exponential(base, limit time.Duration, jitter float64) Policy {
+	return PolicyFunc(func(attempt int, _ time.Duration) (time.Duration, bool) {
+		d := base
+		for i := 1; i < attempt && (limit <= 0 || d < limit) && d < time.Duration(1<<62); i++ {
+			d *= 2
+		}
+		return applyJitter(capDelay(d, limit), jitter), true
+	})
+}
+
+// Fibonacci waits base, base, 2*base, 3*base, 5*base, ... capped at limit when limit > 0.
+func Fibonacci(base, limit time.Duration) Policy {
+	return PolicyFunc(func(attempt int, _ time.Duration) (time.Duration, bool) {
+		prev, cur := time.Duration(0), base
+		for i := 1; i < attempt && (limit <= 0 || cur < limit) && cur < time.Duration(1<<62); i++ {
+			prev, cur = cur, prev+cur
+		}
+		return capDelay(cur, limit), true
+	})
+}
+
+// MaxAttempts stops p after n attempts in total.
+func MaxAttempts(p Policy, n int) Policy {
+	return keepClock(p, PolicyFunc(func(attempt int, elapsed time.Duration) (time.Duration, bool) {
+		if attempt >= n {
+			return 0, false
+		}
+		return p.Next(attempt, elapsed)
+	}))
+}
+
+// MaxElapsed stops p when the next attempt would start after d has elapsed.
+func MaxElapsed(p Policy, d time.Duration) Policy {
+	return keepClock(p, PolicyFunc(func(attempt int, elapsed time.Duration) (time.Duration, bool) {
+		delay, ok := p.Next(attempt, elapsed)
+		if !ok || elapsed+delay > d {
+			return 0, false
+		}
+		return delay, true
+	}))
+}
+
+func capDelay(d, limit time.Duration) time.Duration {
+	if limit > 0 && d > limit {
+		return limit
+	}
+	return d
+}
+
+func applyJitter(d time.Duration, jitter float64) time.Duration {
+	if jitter <= 0 || d <= 0 {
+		return d
+	}
+	return d - time.Duration(rand.Float64()*min(jitter, 1)*float64(d))
+}
+
+// Clock abstracts time so retries can be tested without sleeping.
+type Clock interface {
+	Now() time.Time
+	Sleep(ctx context.Context, d time.Duration) error
+}
+
+type realClock struct{}
+
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+func (realClock) Sleep(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+type clockPolicy struct {
+	Policy
+	clock Clock
+}
+
+// WithClock makes Retry measure and sleep with clock while following p.
+// MaxAttempts and MaxElapsed keep the clock of the policy they wrap.
+func WithClock(p Policy, clock Clock) Policy {
+	return clockPolicy{Policy: p, clock: clock}
+}
+
+func keepClock(from, to Policy) Policy {
+	if cp, ok := from.(clockPolicy); ok {
+		return clockPolicy{Policy: to, clock: cp.clock}
+	}
+	return to
+}
+
+func policyClock(p Policy) Clock {
+	if cp, ok := p.(clockPolicy); ok {
+		return cp.clock
+	}
+	return realClock{}
+}
+
+// RetryError holds the error of every failed attempt in order. ContextErr is
+// set when ctx ended before the policy gave up.
+type RetryError[E error] struct {
+	Errors     []E
+	ContextErr error
+}
+
+func (e *RetryError[E]) Error() string {
+	if len(e.Errors) == 0 {
+		return fmt.Sprintf("retry: no attempt made: %v", e.ContextErr)
+	}
+	msg := fmt.Sprintf("retry: %d attempts failed, last error: %v", len(e.Errors), e.Last())
+	if e.ContextErr != nil {
+		msg += fmt.Sprintf(" (stopped: %v)", e.ContextErr)
+	}
+	return msg
+}
+
+// Last returns the error of the final attempt.
+func (e *RetryError[E]) Last() E {
+	if len(e.Errors) == 0 {
+		var zero E
+		return zero
+	}
+	return e.Errors[len(e.Errors)-1]
+}
+
+func (e *RetryError[E]) Unwrap() []error {
+	errs := make([]error, 0, len(e.Errors)+1)
+	for _, err := range e.Errors {
+		errs = append(errs, err)
+	}
+	if e.ContextErr != nil {
+		errs = append(errs, e.ContextErr)
+	}
+	return errs
+}
+
+type retryConfig[E error] struct {
+	retryIf func(E) bool
+	onRetry func(attempt int, err E, delay time.Duration)
+}
+
+type RetryOption[E error] func(*retryConfig[E])
+
+// RetryIf retries only errors for which pred returns true; others are permanent.
+func RetryIf[E error](pred func(E) bool) RetryOption[E] {
+	return func(c *retryConfig[E]) {
+		c.retryIf = pred
+	}
+}
+
+// OnRetry calls fn before waiting for the next attempt.
+func OnRetry[E error](fn func(attempt int, err E, delay time.Duration)) RetryOption[E] {
+	return func(c *retryConfig[E]) {
+		c.onRetry = fn
+	}
+}
+
+// Retry calls f until it returns Ok, the policy gives up, an error is
+// rejected by RetryIf, or ctx ends. Unlike f, it fails with a *RetryError[E]
+// that holds the error of every attempt plus the context error, if any; use
+// Last for the final E alone.
+func Retry[T any, E error](ctx context.Context, policy Policy, f func(context.Context) Result[T, E], opts ...RetryOption[E]) Result[T, *RetryError[E]] {
+	var cfg retryConfig[E]
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	clock := policyClock(policy)
+
+	start := clock.Now()
+	var errs []E
+	for attempt := 1; ; attempt++ {
+		if err := ctx.Err(); err != nil {
+			return Err[T](&RetryError[E]{Errors: errs, ContextErr: err})
+		}
+		r := f(ctx)
+		if r.ok {
+			return Ok[T, *RetryError[E]](r.value)
+		}
+		errs = append(errs, r.err)
+
+		if cfg.retryIf != nil && !cfg.retryIf(r.err) {
+			return Err[T](&RetryError[E]{Errors: errs})
+		}
+		delay, ok := policy.Next(attempt, clock.Now().Sub(start))
+		if !ok {
+			return Err[T](&RetryError[E]{Errors: errs})
+		}
+		if cfg.onRetry != nil {
+			cfg.onRetry(attempt, r.err, delay)
+		}
+		if err := clock.Sleep(ctx, delay); err != nil {
+			return Err[T](&RetryError[E]{Errors: errs, ContextErr: err})
+		}
+	}
+}
@@ -0,0 +1,152 @@
+package result_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/magicdrive/maybe/result"
+)
+
+type fakeClock struct {
+	now    time.Time
+	sleeps []time.Duration
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func (c *fakeClock) Sleep(ctx context.Context, d time.Duration) error {
+	c.sleeps = append(c.sleeps, d)
+	c.now = c.now.Add(d)
+	return ctx.Err()
+}
+
+func failing(n int, err error) func(context.Context) result.Result[int, error] {
+	calls := 0
+	return func(context.Context) result.Result[int, error] {
+		calls++
+		if calls <= n {
+			return result.Err[int](err)
+		}
+		return result.Ok[int, error](calls)
+	}
+}
+
+func TestRetrySucceedsAfterFailures(t *testing.T) {
+	clock := &fakeClock{}
+	var hooks []int
+	r := result.Retry(context.Background(),
+		result.WithClock(result.MaxAttempts(result.Constant(time.Second), 5), clock),
+		failing(2, errors.New("flaky")),
+		result.OnRetry(func(attempt int, err error, delay time.Duration) {
+			hooks = append(hooks, attempt)
+		}),
+	)
+	if r.Unwrap() != 3 {
+		t.Errorf("expected success on third attempt, got %v", r)
+	}
+	if !reflect.DeepEqual(clock.sleeps, []time.Duration{time.Second, time.Second}) {
+		t.Errorf("unexpected sleeps: %v", clock.sleeps)
+	}
+	if !reflect.DeepEqual(hooks, []int{1, 2}) {
+		t.Errorf("unexpected OnRetry calls: %v", hooks)
+	}
+}
+
+func TestRetryGivesUpWithAllErrors(t *testing.T) {
+	flaky := errors.New("flaky")
+	r := result.Retry(context.Background(),
+		result.MaxAttempts(result.WithClock(result.Constant(time.Second), &fakeClock{}), 3),
+		failing(10, flaky),
+	)
+	re := r.UnwrapErr()
+	if len(re.Errors) != 3 || re.Last() != flaky {
+		t.Errorf("expected 3 attempt errors, got %v", re.Errors)
+	}
+	if !errors.Is(re, flaky) {
+		t.Errorf("expected errors.Is to find attempt error")
+	}
+	if re.Error() != "retry: 3 attempts failed, last error: flaky" {
+		t.Errorf("unexpected message: %s", re.Error())
+	}
+}
+
+func TestRetryIfStopsOnPermanentError(t *testing.T) {
+	permanent := errors.New("permanent")
+	r := result.Retry(context.Background(),
+		result.WithClock(result.Constant(time.Second), &fakeClock{}),
+		failing(10, permanent),
+		result.RetryIf(func(err error) bool { return err != permanent }),
+	)
+	if n := len(r.UnwrapErr().Errors); n != 1 {
+		t.Errorf("expected a single attempt, got %d", n)
+	}
+}
+
+func TestRetryMaxElapsed(t *testing.T) {
+	clock := &fakeClock{}
+	r := result.Retry(context.Background(),
+		result.MaxElapsed(result.WithClock(result.Constant(4*time.Second), clock), 10*time.Second),
+		failing(10, errors.New("x")),
+	)
+	if n := len(r.UnwrapErr().Errors); n != 3 {
+		t.Errorf("expected 3 attempts within 10s, got %d (sleeps %v)", n, clock.sleeps)
+	}
+}
+
+func TestRetryStopsOnContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	r := result.Retry(ctx, result.WithClock(result.Constant(time.Second), &fakeClock{}),
+		func(context.Context) result.Result[int, error] {
+			cancel()
+			return result.Err[int](errors.New("x"))
+		},
+	)
+	re := r.UnwrapErr()
+	if len(re.Errors) != 1 || !errors.Is(re, context.Canceled) {
+		t.Errorf("expected one attempt and context.Canceled, got %v", re)
+	}
+}
+
+func TestBackoffPolicies(t *testing.T) {
+	delays := func(p result.Policy, n int) []time.Duration {
+		var ds []time.Duration
+		for i := 1; i <= n; i++ {
+			d, ok := p.Next(i, 0)
+			if !ok {
+				break
+			}
+			ds = append(ds, d)
+		}
+		return ds
+	}
+	ms := time.Millisecond
+
+	exp := delays(result.Exponential(ms, 10*ms, 0), 6)
+	if !reflect.DeepEqual(exp, []time.Duration{ms, 2 * ms, 4 * ms, 8 * ms, 10 * ms, 10 * ms}) {
+		t.Errorf("unexpected exponential delays: %v", exp)
+	}
+
+	fib := delays(result.Fibonacci(ms, 0), 6)
+	if !reflect.DeepEqual(fib, []time.Duration{ms, ms, 2 * ms, 3 * ms, 5 * ms, 8 * ms}) {
+		t.Errorf("unexpected fibonacci delays: %v", fib)
+	}
+
+	for _, d := range delays(result.Exponential(100*ms, 0, 0.5), 3) {
+		if d < 50*ms || d > 400*ms {
+			t.Errorf("jittered delay out of range: %v", d)
+		}
+	}
+
+	if d, ok := result.Exponential(time.Second, 0, 0).Next(200, 0); !ok || d <= 0 {
+		t.Errorf("expected large attempt numbers not to overflow, got %v", d)
+	}
+
+	if n := len(delays(result.MaxAttempts(result.Constant(ms), 3), 10)); n != 2 {
+		t.Errorf("expected 2 delays for 3 attempts, got %d", n)
+	}
+}
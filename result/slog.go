@@ -0,0 +1,48 @@
+package result
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+)
+
+func errAttrs(err error) []slog.Attr {
+	if isNilError(err) {
+		return []slog.Attr{slog.Any("error", nil)}
+	}
+	typed := err
+	for fe, ok := typed.(*FieldsError); ok && fe.Err != nil; fe, ok = typed.(*FieldsError) {
+		typed = fe.Err
+	}
+	attrs := []slog.Attr{
+		slog.String("error", err.Error()),
+		slog.String("error_type", fmt.Sprintf("%T", typed)),
+	}
+	if fields := Fields(err); len(fields) > 0 {
+		attrs = append(attrs, slog.Group("fields", fields...))
+	}
+	return attrs
+}
+
+// LogValue implements slog.LogValuer. Ok(v) logs as v's own resolved value
+// and Err(e) logs as a group with error and error_type fields, plus a
+// fields group when the error carries WithFields context.
+func (r Result[T, E]) LogValue() slog.Value {
+	if r.ok {
+		return slog.AnyValue(r.value).Resolve()
+	}
+	return slog.GroupValue(errAttrs(r.err)...)
+}
+
+// LogErr logs msg at error level when r is an Err and returns r unchanged.
+// A nil logger means slog.Default().
+func LogErr[T any, E error](ctx context.Context, logger *slog.Logger, r Result[T, E], msg string) Result[T, E] {
+	if r.ok {
+		return r
+	}
+	if logger == nil {
+		logger = slog.Default()
+	}
+	logger.LogAttrs(ctx, slog.LevelError, msg, errAttrs(r.err)...)
+	return r
+}
@@ -0,0 +1,72 @@
+package result_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/magicdrive/maybe/result"
+)
+
+func newTestLogger(buf *bytes.Buffer) *slog.Logger {
+	return slog.New(slog.NewJSONHandler(buf, &slog.HandlerOptions{
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if a.Key == slog.TimeKey {
+				return slog.Attr{}
+			}
+			return a
+		},
+	}))
+}
+
+func TestResultLogValue(t *testing.T) {
+	var buf bytes.Buffer
+	logger := newTestLogger(&buf)
+
+	logger.Info("done", "a", result.Ok[int, error](1), "b", result.Err[int](&MyErr{msg: "bad"}))
+
+	got := strings.TrimSpace(buf.String())
+	want := `{"level":"INFO","msg":"done","a":1,"b":{"error":"bad","error_type":"*result_test.MyErr"}}`
+	if got != want {
+		t.Errorf("expected %s, got %s", want, got)
+	}
+}
+
+func TestLogErr(t *testing.T) {
+	var buf bytes.Buffer
+	logger := newTestLogger(&buf)
+	ctx := context.Background()
+
+	ok := result.LogErr(ctx, logger, result.Ok[int, error](1), "should not log")
+	if buf.Len() != 0 {
+		t.Errorf("expected no log for Ok, got %s", buf.String())
+	}
+	if ok.Unwrap() != 1 {
+		t.Errorf("expected Ok unchanged")
+	}
+
+	r := result.LogErr(ctx, logger, result.Err[int](errors.New("boom")), "load failed")
+	got := strings.TrimSpace(buf.String())
+	want := `{"level":"ERROR","msg":"load failed","error":"boom","error_type":"*errors.errorString"}`
+	if got != want {
+		t.Errorf("expected %s, got %s", want, got)
+	}
+	if r.UnwrapErr().Error() != "boom" {
+		t.Errorf("expected Err unchanged")
+	}
+}
+
+func TestLogErrZeroPointerError(t *testing.T) {
+	var buf bytes.Buffer
+	logger := newTestLogger(&buf)
+
+	result.LogErr(context.Background(), logger, result.Result[int, *MyErr]{}, "zero")
+	got := strings.TrimSpace(buf.String())
+	want := `{"level":"ERROR","msg":"zero","error":null}`
+	if got != want {
+		t.Errorf("expected %s, got %s", want, got)
+	}
+}
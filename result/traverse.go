@@ -0,0 +1,49 @@
+package result
+
+import "fmt"
+
+// IndexedError records which input element of TraverseAll failed.
+type IndexedError[E error] struct {
+	Index int
+	Err   E
+}
+
+func (e IndexedError[E]) Error() string {
+	return fmt.Sprintf("index %d: %v", e.Index, e.Err)
+}
+
+func (e IndexedError[E]) Unwrap() error {
+	return e.Err
+}
+
+// Traverse applies f to every element of xs and stops at the first Err.
+func Traverse[A any, B any, E error](xs []A, f func(A) Result[B, E]) Result[[]B, E] {
+	values := make([]B, 0, len(xs))
+	for _, x := range xs {
+		r := f(x)
+		if !r.ok {
+			return Err[[]B](r.err)
+		}
+		values = append(values, r.value)
+	}
+	return Ok[[]B, E](values)
+}
+
+// TraverseAll applies f to every element of xs and reports every Err
+// together with the index of the element that produced it.
+func TraverseAll[A any, B any, E error](xs []A, f func(A) Result[B, E]) Result[[]B, Errors[IndexedError[E]]] {
+	values := make([]B, 0, len(xs))
+	var errs Errors[IndexedError[E]]
+	for i, x := range xs {
+		r := f(x)
+		if !r.ok {
+			errs = append(errs, IndexedError[E]{Index: i, Err: r.err})
+			continue
+		}
+		values = append(values, r.value)
+	}
+	if len(errs) > 0 {
+		return Err[[]B](errs)
+	}
+	return Ok[[]B, Errors[IndexedError[E]]](values)
+}
@@ -0,0 +1,58 @@
+package result_test
+
+import (
+	"errors"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/magicdrive/maybe/result"
+)
+
+func parseRow(s string) result.Result[int, error] {
+	return result.From(strconv.Atoi(s))
+}
+
+func TestPartition(t *testing.T) {
+	oks, errs := result.Partition([]result.Result[int, error]{
+		parseRow("1"), parseRow("x"), parseRow("3"),
+	})
+	if !reflect.DeepEqual(oks, []int{1, 3}) || len(errs) != 1 {
+		t.Errorf("unexpected partition: %v %v", oks, errs)
+	}
+}
+
+func TestTraverse(t *testing.T) {
+	got := result.Traverse([]string{"1", "2"}, parseRow)
+	if !reflect.DeepEqual(got.Unwrap(), []int{1, 2}) {
+		t.Errorf("expected Ok([1 2]), got %v", got)
+	}
+
+	calls := 0
+	failed := result.Traverse([]string{"1", "x", "y"}, func(s string) result.Result[int, error] {
+		calls++
+		return parseRow(s)
+	})
+	if failed.IsOk() || calls != 2 {
+		t.Errorf("expected Err after 2 calls, got %v after %d", failed, calls)
+	}
+}
+
+func TestTraverseAll(t *testing.T) {
+	r := result.TraverseAll([]string{"1", "x", "3", "y"}, parseRow)
+	errs := r.UnwrapErr()
+	if len(errs) != 2 || errs[0].Index != 1 || errs[1].Index != 3 {
+		t.Fatalf("expected errors at index 1 and 3, got %v", errs)
+	}
+	if msg := errs[0].Error(); msg != `index 1: strconv.Atoi: parsing "x": invalid syntax` {
+		t.Errorf("unexpected message: %s", msg)
+	}
+	if !errors.Is(errs, strconv.ErrSyntax) {
+		t.Errorf("expected errors.Is to reach strconv.ErrSyntax")
+	}
+
+	ok := result.TraverseAll([]string{"1"}, parseRow)
+	if !reflect.DeepEqual(ok.Unwrap(), []int{1}) {
+		t.Errorf("expected Ok([1]), got %v", ok)
+	}
+}
@@ -0,0 +1,41 @@
+package result
+
+import (
+	"fmt"
+	"runtime/debug"
+)
+
+// PanicError carries a value recovered from a panic and the stack of the
+// goroutine that panicked.
+type PanicError struct {
+	Value any
+	Stack []byte
+}
+
+func newPanicError(v any) *PanicError {
+	return &PanicError{Value: v, Stack: debug.Stack()}
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("panic: %v", e.Value)
+}
+
+// Unwrap returns the recovered value when it is an error.
+func (e *PanicError) Unwrap() error {
+	err, _ := e.Value.(error)
+	return err
+}
+
+// TryRecover is like From(f()) but turns a panic in f into an Err holding a
+// *PanicError. A Get aborting an enclosing Do is not recovered.
+func TryRecover[T any](f func() (T, error)) (r Result[T, error]) {
+	defer func() {
+		if v := recover(); v != nil {
+			if isScopeAbort(v) {
+				panic(v)
+			}
+			r = Err[T, error](newPanicError(v))
+		}
+	}()
+	return From(f())
+}
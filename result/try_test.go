@@ -2,6 +2,7 @@ package result_test
 
 import (
 	"errors"
+	"strings"
 	"testing"
 
 	"github.com/magicdrive/maybe/result"
@@ -61,3 +62,49 @@ func TestTry_Failure(t *testing.T) {
 		t.Errorf("expected wrapped error, got %v", res.UnwrapErr())
 	}
 }
+
+func TestTryRecover(t *testing.T) {
+	ok := result.TryRecover(func() (int, error) { return 1, nil })
+	if ok.Unwrap() != 1 {
+		t.Errorf("expected Ok(1)")
+	}
+
+	boom := errors.New("boom")
+	failed := result.TryRecover(func() (int, error) { return 0, boom })
+	if failed.UnwrapErr() != boom {
+		t.Errorf("expected Err(boom)")
+	}
+
+	panicked := result.TryRecover(func() (int, error) { panic("kaboom") })
+	var pe *result.PanicError
+	if !errors.As(panicked.UnwrapErr(), &pe) {
+		t.Fatalf("expected *PanicError, got %v", panicked)
+	}
+	if pe.Value != "kaboom" || !strings.Contains(string(pe.Stack), "TestTryRecover") {
+		t.Errorf("expected recovered value and stack, got %v\n%s", pe.Value, pe.Stack)
+	}
+}
+
+func TestTryRecoverDistinguishesUnwrapPanics(t *testing.T) {
+	r := result.TryRecover(func() (int, error) {
+		return result.Err[int](errors.New("inner")).Unwrap(), nil
+	})
+	if !errors.Is(r.UnwrapErr(), result.ErrUnwrapOnErr) {
+		t.Errorf("expected ErrUnwrapOnErr, got %v", r.UnwrapErr())
+	}
+
+	r = result.TryRecover(func() (int, error) {
+		result.Ok[int, error](1).UnwrapErr()
+		return 0, nil
+	})
+	if !errors.Is(r.UnwrapErr(), result.ErrUnwrapErrOnOk) {
+		t.Errorf("expected ErrUnwrapErrOnOk, got %v", r.UnwrapErr())
+	}
+
+	r = result.TryRecover(func() (int, error) {
+		panic(errors.New("other"))
+	})
+	if errors.Is(r.UnwrapErr(), result.ErrUnwrapOnErr) {
+		t.Errorf("expected unrelated panic not to match ErrUnwrapOnErr")
+	}
+}
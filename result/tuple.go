@@ -0,0 +1,27 @@
+package result
+
+import "github.com/magicdrive/maybe/tuple"
+
+func From2[A any, B any](a A, b B, err error) Result[tuple.Pair[A, B], error] {
+	return From(tuple.NewPair(a, b), err)
+}
+
+func From3[A any, B any, C any](a A, b B, c C, err error) Result[tuple.Triple[A, B, C], error] {
+	return From(tuple.NewTriple(a, b, c), err)
+}
+
+func Try2[A any, B any, E error](f func() (A, B, error), wrap func(error) E) Result[tuple.Pair[A, B], E] {
+	a, b, err := f()
+	if err != nil {
+		return Err[tuple.Pair[A, B]](wrap(err))
+	}
+	return Ok[tuple.Pair[A, B], E](tuple.NewPair(a, b))
+}
+
+func Try3[A any, B any, C any, E error](f func() (A, B, C, error), wrap func(error) E) Result[tuple.Triple[A, B, C], E] {
+	a, b, c, err := f()
+	if err != nil {
+		return Err[tuple.Triple[A, B, C]](wrap(err))
+	}
+	return Ok[tuple.Triple[A, B, C], E](tuple.NewTriple(a, b, c))
+}
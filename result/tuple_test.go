@@ -0,0 +1,53 @@
+package result_test
+
+import (
+	"errors"
+	"net"
+	"strconv"
+	"testing"
+
+	"github.com/magicdrive/maybe/result"
+	"github.com/magicdrive/maybe/tuple"
+)
+
+func TestFrom2AndFrom3(t *testing.T) {
+	r := result.From2(net.SplitHostPort("localhost:8080"))
+	if r.Unwrap() != tuple.NewPair("localhost", "8080") {
+		t.Errorf("unexpected pair: %v", r)
+	}
+	if result.From2(net.SplitHostPort("bad")).IsOk() {
+		t.Errorf("expected Err")
+	}
+
+	three := func() (int, int, int, error) { return 1, 2, 3, nil }
+	if result.From3(three()).Unwrap() != tuple.NewTriple(1, 2, 3) {
+		t.Errorf("unexpected triple")
+	}
+}
+
+func TestTry2AndTry3(t *testing.T) {
+	wrap := func(e error) MyErr { return MyErr{msg: "wrapped: " + e.Error()} }
+
+	ok := result.Try2(func() (string, string, error) { return net.SplitHostPort("a:1") }, wrap)
+	host, port := ok.Unwrap().Unpack()
+	if host != "a" || port != "1" {
+		t.Errorf("unexpected unpack: %s %s", host, port)
+	}
+
+	failed := result.Try3(func() (int, int, int, error) { return 0, 0, 0, errors.New("x") }, wrap)
+	if failed.UnwrapErr().msg != "wrapped: x" {
+		t.Errorf("unexpected error: %v", failed)
+	}
+}
+
+func TestResultGet(t *testing.T) {
+	v, err := result.From(strconv.Atoi("12")).Get()
+	if v != 12 || err != nil {
+		t.Errorf("expected (12, nil), got (%v, %v)", v, err)
+	}
+
+	v, err = result.Err[int](&MyErr{msg: "bad"}).Get()
+	if v != 0 || err == nil || err.Error() != "bad" {
+		t.Errorf("expected (0, bad), got (%v, %v)", v, err)
+	}
+}
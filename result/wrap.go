@@ -0,0 +1,71 @@
+package result
+
+import "fmt"
+
+// Context wraps the error of an Err with a formatted message, as
+// fmt.Errorf(format+": %w", args..., err) would. Ok passes through, but
+// boxing args into ...any at the call site may still allocate; use
+// WithContextf on hot paths where Ok must cost nothing.
+func Context[T any, E error](r Result[T, E], format string, args ...any) Result[T, error] {
+	if r.ok {
+		return Ok[T, error](r.value)
+	}
+	args = append(args[:len(args):len(args)], r.err)
+	return Err[T](fmt.Errorf(format+": %w", args...))
+}
+
+// WithContextf is like Context but builds the message only for Err, so
+// wrapping an Ok never allocates.
+func WithContextf[T any, E error](r Result[T, E], msg func() string) Result[T, error] {
+	if r.ok {
+		return Ok[T, error](r.value)
+	}
+	return Err[T](fmt.Errorf("%s: %w", msg(), r.err))
+}
+
+// FieldsError attaches key/value pairs to an error without changing its message.
+type FieldsError struct {
+	Fields []any
+	Err    error
+}
+
+func (e *FieldsError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *FieldsError) Unwrap() error {
+	return e.Err
+}
+
+// WithFields attaches alternating key/value pairs, as accepted by
+// slog.Logger, to the error of an Err. Use Fields to read them back. As with
+// Context, boxing kv at the call site may allocate even for Ok.
+func WithFields[T any, E error](r Result[T, E], kv ...any) Result[T, error] {
+	if r.ok {
+		return Ok[T, error](r.value)
+	}
+	return Err[T, error](&FieldsError{Fields: append([]any(nil), kv...), Err: r.err})
+}
+
+// Fields collects the key/value pairs of every FieldsError in the chain of
+// err, outermost first.
+func Fields(err error) []any {
+	var fields []any
+	for err != nil {
+		if fe, ok := err.(*FieldsError); ok {
+			fields = append(fields, fe.Fields...)
+		}
+		switch x := err.(type) {
+		case interface{ Unwrap() error }:
+			err = x.Unwrap()
+		case interface{ Unwrap() []error }:
+			for _, e := range x.Unwrap() {
+				fields = append(fields, Fields(e)...)
+			}
+			return fields
+		default:
+			return fields
+		}
+	}
+	return fields
+}
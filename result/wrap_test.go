@@ -0,0 +1,108 @@
+package result_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/fs"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/magicdrive/maybe/result"
+)
+
+func TestContext(t *testing.T) {
+	r := result.Context(result.Err[int](fs.ErrNotExist), "loading config %s", "app.toml")
+	if msg := r.UnwrapErr().Error(); msg != "loading config app.toml: file does not exist" {
+		t.Errorf("unexpected message: %s", msg)
+	}
+	if !r.ErrIs(fs.ErrNotExist) {
+		t.Errorf("expected errors.Is to see through the context")
+	}
+
+	nf := &NotFoundError{Resource: "user"}
+	typed := result.Context(result.Err[int](nf), "fetch")
+	if got, ok := result.ErrAs[*NotFoundError](typed); !ok || got != nf {
+		t.Errorf("expected errors.As to see through the context")
+	}
+
+	if result.Context(result.Ok[int, error](1), "unused %d", 2).Unwrap() != 1 {
+		t.Errorf("expected Ok unchanged")
+	}
+}
+
+func TestWithContextf(t *testing.T) {
+	called := false
+	msg := func() string {
+		called = true
+		return "expensive"
+	}
+	if result.WithContextf(result.Ok[int, error](1), msg).Unwrap() != 1 || called {
+		t.Errorf("expected message not to be built for Ok")
+	}
+
+	r := result.WithContextf(result.Err[int](fs.ErrPermission), msg)
+	if r.UnwrapErr().Error() != "expensive: permission denied" || !r.ErrIs(fs.ErrPermission) {
+		t.Errorf("unexpected: %v", r)
+	}
+}
+
+func TestWrapOkDoesNotAllocate(t *testing.T) {
+	ok := result.Ok[int, error](1)
+	path := strings.Repeat("x", 3)
+	allocs := testing.AllocsPerRun(100, func() {
+		_ = result.WithContextf(ok, func() string { return "loading " + path })
+	})
+	if allocs != 0 {
+		t.Errorf("expected zero allocations for WithContextf on Ok, got %v", allocs)
+	}
+
+	// Context and WithFields add nothing beyond boxing their arguments,
+	// which the caller pays for; with the boxes built up front they are free.
+	args := []any{path}
+	kv := []any{"path", path}
+	allocs = testing.AllocsPerRun(100, func() {
+		_ = result.Context(ok, "loading config %s", args...)
+		_ = result.WithFields(ok, kv...)
+	})
+	if allocs != 0 {
+		t.Errorf("expected zero allocations for Context and WithFields on Ok, got %v", allocs)
+	}
+}
+
+func TestWithFields(t *testing.T) {
+	r := result.WithFields(result.Err[int](errors.New("boom")), "user_id", 42)
+	r = result.Context(r, "handle request")
+	r = result.WithFields(r, "request_id", "abc")
+
+	err := r.UnwrapErr()
+	if err.Error() != "handle request: boom" {
+		t.Errorf("expected fields not to change the message, got %s", err.Error())
+	}
+	want := []any{"request_id", "abc", "user_id", 42}
+	if got := result.Fields(err); !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+
+	joined := errors.Join(err, result.WithFields(result.Err[int](errors.New("x")), "k", 1).UnwrapErr())
+	if got := result.Fields(joined); len(got) != 6 {
+		t.Errorf("expected fields from both joined errors, got %v", got)
+	}
+
+	if result.WithFields(result.Ok[int, error](1), "k", "v").Unwrap() != 1 {
+		t.Errorf("expected Ok unchanged")
+	}
+}
+
+func TestWithFieldsLogged(t *testing.T) {
+	var buf bytes.Buffer
+	r := result.WithFields(result.Err[int](errors.New("boom")), "user_id", 42)
+	result.LogErr(context.Background(), newTestLogger(&buf), r, "failed")
+
+	got := strings.TrimSpace(buf.String())
+	want := `{"level":"ERROR","msg":"failed","error":"boom","error_type":"*errors.errorString","fields":{"user_id":42}}`
+	if got != want {
+		t.Errorf("expected %s, got %s", want, got)
+	}
+}
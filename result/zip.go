@@ -0,0 +1,98 @@
+package result
+
+import "github.com/magicdrive/maybe/tuple"
+
+func Zip[A any, B any, E error](a Result[A, E], b Result[B, E]) Result[tuple.Pair[A, B], E] {
+	return ZipWith(a, b, tuple.NewPair[A, B])
+}
+
+func Zip3[A any, B any, C any, E error](a Result[A, E], b Result[B, E], c Result[C, E]) Result[tuple.Triple[A, B, C], E] {
+	return Lift3[E](tuple.NewTriple[A, B, C])(a, b, c)
+}
+
+func Unzip[A any, B any, E error](r Result[tuple.Pair[A, B], E]) (Result[A, E], Result[B, E]) {
+	if !r.ok {
+		return Err[A](r.err), Err[B](r.err)
+	}
+	return Ok[A, E](r.value.First), Ok[B, E](r.value.Second)
+}
+
+// ZipWith combines the values of a and b with f. When either is Err, the
+// first Err in argument order is returned.
+func ZipWith[A any, B any, R any, E error](a Result[A, E], b Result[B, E], f func(A, B) R) Result[R, E] {
+	if !a.ok {
+		return Err[R](a.err)
+	}
+	if !b.ok {
+		return Err[R](b.err)
+	}
+	return Ok[R, E](f(a.value, b.value))
+}
+
+// Apply calls the function in rf with the value in ra when both are Ok.
+func Apply[A any, B any, E error](rf Result[func(A) B, E], ra Result[A, E]) Result[B, E] {
+	if !rf.ok {
+		return Err[B](rf.err)
+	}
+	if !ra.ok {
+		return Err[B](ra.err)
+	}
+	return Ok[B, E](rf.value(ra.value))
+}
+
+// Lift2 through Lift5 turn a plain function into one over Results, returning
+// the first Err in argument order. E comes first so that only it needs to be
+// spelled out, as in Lift2[error](f).
+func Lift2[E error, A any, B any, R any](f func(A, B) R) func(Result[A, E], Result[B, E]) Result[R, E] {
+	return func(a Result[A, E], b Result[B, E]) Result[R, E] {
+		return ZipWith(a, b, f)
+	}
+}
+
+func Lift3[E error, A any, B any, C any, R any](f func(A, B, C) R) func(Result[A, E], Result[B, E], Result[C, E]) Result[R, E] {
+	return func(a Result[A, E], b Result[B, E], c Result[C, E]) Result[R, E] {
+		switch {
+		case !a.ok:
+			return Err[R](a.err)
+		case !b.ok:
+			return Err[R](b.err)
+		case !c.ok:
+			return Err[R](c.err)
+		}
+		return Ok[R, E](f(a.value, b.value, c.value))
+	}
+}
+
+func Lift4[E error, A any, B any, C any, D any, R any](f func(A, B, C, D) R) func(Result[A, E], Result[B, E], Result[C, E], Result[D, E]) Result[R, E] {
+	return func(a Result[A, E], b Result[B, E], c Result[C, E], d Result[D, E]) Result[R, E] {
+		switch {
+		case !a.ok:
+			return Err[R](a.err)
+		case !b.ok:
+			return Err[R](b.err)
+		case !c.ok:
+			return Err[R](c.err)
+		case !d.ok:
+			return Err[R](d.err)
+		}
+		return Ok[R, E](f(a.value, b.value, c.value, d.value))
+	}
+}
+
+func Lift5[E error, A any, B any, C any, D any, F any, R any](f func(A, B, C, D, F) R) func(Result[A, E], Result[B, E], Result[C, E], Result[D, E], Result[F, E]) Result[R, E] {
+	return func(a Result[A, E], b Result[B, E], c Result[C, E], d Result[D, E], e Result[F, E]) Result[R, E] {
+		switch {
+		case !a.ok:
+			return Err[R](a.err)
+		case !b.ok:
+			return Err[R](b.err)
+		case !c.ok:
+			return Err[R](c.err)
+		case !d.ok:
+			return Err[R](d.err)
+		case !e.ok:
+			return Err[R](e.err)
+		}
+		return Ok[R, E](f(a.value, b.value, c.value, d.value, e.value))
+	}
+}
@@ -0,0 +1,75 @@
+package result_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/magicdrive/maybe/result"
+	"github.com/magicdrive/maybe/tuple"
+)
+
+func TestZipUnzip(t *testing.T) {
+	z := result.Zip(result.Ok[int, error](1), result.Ok[string, error]("a"))
+	if z.Unwrap() != tuple.NewPair(1, "a") {
+		t.Errorf("expected Ok((1, a)), got %v", z)
+	}
+
+	first := errors.New("first")
+	failed := result.Zip(result.Err[int](first), result.Err[string](errors.New("second")))
+	if failed.UnwrapErr() != first {
+		t.Errorf("expected first error, got %v", failed)
+	}
+
+	a, b := result.Unzip(z)
+	if a.Unwrap() != 1 || b.Unwrap() != "a" {
+		t.Errorf("unexpected unzip: %v %v", a, b)
+	}
+	ea, eb := result.Unzip(failed)
+	if ea.UnwrapErr() != first || eb.UnwrapErr() != first {
+		t.Errorf("expected error on both sides")
+	}
+
+	z3 := result.Zip3(result.Ok[int, error](1), result.Ok[string, error]("a"), result.Ok[bool, error](true))
+	if z3.Unwrap() != tuple.NewTriple(1, "a", true) {
+		t.Errorf("unexpected Zip3: %v", z3)
+	}
+}
+
+func TestZipWithApplyLift(t *testing.T) {
+	ok := func(x int) result.Result[int, error] { return result.Ok[int, error](x) }
+	boom := errors.New("boom")
+
+	if result.ZipWith(ok(2), ok(3), func(a, b int) int { return a * b }).Unwrap() != 6 {
+		t.Errorf("expected Ok(6)")
+	}
+
+	inc := result.Ok[func(int) int, error](func(x int) int { return x + 1 })
+	if result.Apply(inc, ok(1)).Unwrap() != 2 {
+		t.Errorf("expected Ok(2)")
+	}
+	if result.Apply(inc, result.Err[int](boom)).UnwrapErr() != boom {
+		t.Errorf("expected boom")
+	}
+
+	sum := func(xs ...int) int {
+		total := 0
+		for _, x := range xs {
+			total += x
+		}
+		return total
+	}
+	lift2 := result.Lift2[error](func(a, b int) int { return sum(a, b) })
+	lift3 := result.Lift3[error](func(a, b, c int) int { return sum(a, b, c) })
+	lift4 := result.Lift4[error](func(a, b, c, d int) int { return sum(a, b, c, d) })
+	lift5 := result.Lift5[error](func(a, b, c, d, e int) int { return sum(a, b, c, d, e) })
+
+	if lift2(ok(1), ok(2)).Unwrap() != 3 || lift3(ok(1), ok(2), ok(3)).Unwrap() != 6 {
+		t.Errorf("unexpected Lift2/Lift3 result")
+	}
+	if lift4(ok(1), ok(2), ok(3), ok(4)).Unwrap() != 10 || lift5(ok(1), ok(2), ok(3), ok(4), ok(5)).Unwrap() != 15 {
+		t.Errorf("unexpected Lift4/Lift5 result")
+	}
+	if lift5(ok(1), ok(2), ok(3), result.Err[int](boom), result.Err[int](errors.New("later"))).UnwrapErr() != boom {
+		t.Errorf("expected first error")
+	}
+}
@@ -0,0 +1,19 @@
+package maybe
+
+import "log/slog"
+
+// LogValue implements slog.LogValuer. None logs as null and Some(v) logs as
+// v's own resolved value.
+func (m Maybe[T]) LogValue() slog.Value {
+	if !m.valid {
+		return slog.AnyValue(nil)
+	}
+	return slog.AnyValue(m.value).Resolve()
+}
+
+func (m MaybePrimitive[T]) LogValue() slog.Value {
+	if m.value == nil {
+		return slog.AnyValue(nil)
+	}
+	return slog.AnyValue(*m.value).Resolve()
+}
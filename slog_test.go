@@ -0,0 +1,41 @@
+package maybe_test
+
+import (
+	"bytes"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/magicdrive/maybe"
+)
+
+type secret string
+
+func (s secret) LogValue() slog.Value {
+	return slog.StringValue("***")
+}
+
+func TestMaybeLogValue(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if a.Key == slog.TimeKey {
+				return slog.Attr{}
+			}
+			return a
+		},
+	}))
+
+	logger.Info("user",
+		"age", maybe.Some(20),
+		"nickname", maybe.None[string](),
+		"score", maybe.SomePrimitive(1.5),
+		"token", maybe.Some(secret("hunter2")),
+	)
+
+	got := strings.TrimSpace(buf.String())
+	want := `{"level":"INFO","msg":"user","age":20,"nickname":null,"score":1.5,"token":"***"}`
+	if got != want {
+		t.Errorf("expected %s, got %s", want, got)
+	}
+}
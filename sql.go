@@ -0,0 +1,56 @@
+package maybe
+
+import (
+	"database/sql"
+	"database/sql/driver"
+)
+
+func FromSQLNull[T any](n sql.Null[T]) Maybe[T] {
+	return FromValue(n.V, n.Valid)
+}
+
+func (m Maybe[T]) ToSQLNull() sql.Null[T] {
+	return sql.Null[T]{V: m.value, Valid: m.valid}
+}
+
+// Scan implements sql.Scanner. SQL NULL becomes None; other values are
+// converted the same way sql.Null[T] converts them.
+func (m *Maybe[T]) Scan(src any) error {
+	var n sql.Null[T]
+	if err := n.Scan(src); err != nil {
+		return err
+	}
+	*m = FromSQLNull(n)
+	return nil
+}
+
+// Value implements driver.Valuer. None is written as SQL NULL.
+func (m Maybe[T]) Value() (driver.Value, error) {
+	return m.ToSQLNull().Value()
+}
+
+// --- MaybePrimitive ---
+
+func FromSQLNullPrimitive[T Primitive](n sql.Null[T]) MaybePrimitive[T] {
+	return FromValuePrimitive(n.V, n.Valid)
+}
+
+func (m MaybePrimitive[T]) ToSQLNull() sql.Null[T] {
+	if m.value == nil {
+		return sql.Null[T]{}
+	}
+	return sql.Null[T]{V: *m.value, Valid: true}
+}
+
+func (m *MaybePrimitive[T]) Scan(src any) error {
+	var n sql.Null[T]
+	if err := n.Scan(src); err != nil {
+		return err
+	}
+	*m = FromSQLNullPrimitive(n)
+	return nil
+}
+
+func (m MaybePrimitive[T]) Value() (driver.Value, error) {
+	return m.ToSQLNull().Value()
+}
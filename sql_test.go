@@ -0,0 +1,141 @@
+package maybe_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/magicdrive/maybe"
+)
+
+// fakeDriver serves a single fixed result set and records the arguments
+// of the last executed statement.
+type fakeDriver struct {
+	columns []string
+	rows    [][]driver.Value
+	args    []driver.Value
+}
+
+func (d *fakeDriver) Open(string) (driver.Conn, error) { return &fakeConn{d: d}, nil }
+
+type fakeConn struct{ d *fakeDriver }
+
+func (c *fakeConn) Prepare(string) (driver.Stmt, error) { return &fakeStmt{d: c.d}, nil }
+func (c *fakeConn) Close() error                        { return nil }
+func (c *fakeConn) Begin() (driver.Tx, error)           { return nil, errors.New("not supported") }
+
+type fakeStmt struct{ d *fakeDriver }
+
+func (s *fakeStmt) Close() error  { return nil }
+func (s *fakeStmt) NumInput() int { return -1 }
+
+func (s *fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	s.d.args = args
+	return driver.RowsAffected(1), nil
+}
+
+func (s *fakeStmt) Query(args []driver.Value) (driver.Rows, error) {
+	s.d.args = args
+	return &fakeRows{d: s.d}, nil
+}
+
+type fakeRows struct {
+	d   *fakeDriver
+	pos int
+}
+
+func (r *fakeRows) Columns() []string { return r.d.columns }
+func (r *fakeRows) Close() error      { return nil }
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.pos >= len(r.d.rows) {
+		return io.EOF
+	}
+	copy(dest, r.d.rows[r.pos])
+	r.pos++
+	return nil
+}
+
+var testDriver = &fakeDriver{}
+
+func init() {
+	sql.Register("maybe_fake", testDriver)
+}
+
+func TestMaybeSQLScan(t *testing.T) {
+	testDriver.columns = []string{"name", "age"}
+	testDriver.rows = [][]driver.Value{
+		{"taro", int64(20)},
+		{nil, nil},
+	}
+
+	db, err := sql.Open("maybe_fake", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	rows, err := db.Query("SELECT name, age FROM users")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+
+	var names []maybe.Maybe[string]
+	var ages []maybe.MaybePrimitive[int]
+	for rows.Next() {
+		var name maybe.Maybe[string]
+		var age maybe.MaybePrimitive[int]
+		if err := rows.Scan(&name, &age); err != nil {
+			t.Fatal(err)
+		}
+		names = append(names, name)
+		ages = append(ages, age)
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatal(err)
+	}
+
+	if names[0].UnwrapOr("") != "taro" || ages[0].UnwrapOr(0) != 20 {
+		t.Errorf("expected first row to be Some, got %v %v", names[0], ages[0])
+	}
+	if names[1].IsSome() || ages[1].IsSome() {
+		t.Errorf("expected NULL row to scan as None")
+	}
+}
+
+func TestMaybeSQLValue(t *testing.T) {
+	db, err := sql.Open("maybe_fake", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	_, err = db.Exec("UPDATE users SET name = ?, age = ?, score = ?",
+		maybe.Some("hanako"), maybe.NonePrimitive[int](), maybe.SomePrimitive(1.5))
+	if err != nil {
+		t.Fatal(err)
+	}
+	args := testDriver.args
+	if args[0] != "hanako" || args[1] != nil || args[2] != 1.5 {
+		t.Errorf("unexpected driver args: %#v", args)
+	}
+}
+
+func TestMaybeSQLNullConversion(t *testing.T) {
+	n := maybe.Some(3).ToSQLNull()
+	if !n.Valid || n.V != 3 {
+		t.Errorf("expected valid sql.Null(3), got %+v", n)
+	}
+	if maybe.FromSQLNull(sql.Null[int]{}).IsSome() {
+		t.Errorf("expected invalid sql.Null to become None")
+	}
+	if maybe.FromSQLNullPrimitive(sql.Null[string]{V: "x", Valid: true}).UnwrapOr("") != "x" {
+		t.Errorf("expected Some(x)")
+	}
+	if maybe.NonePrimitive[bool]().ToSQLNull().Valid {
+		t.Errorf("expected NonePrimitive to be invalid sql.Null")
+	}
+}
@@ -0,0 +1,130 @@
+package maybe
+
+import (
+	"encoding"
+	"fmt"
+	"reflect"
+	"strconv"
+	"time"
+)
+
+// MarshalText implements encoding.TextMarshaler. None is encoded as empty
+// text; Some uses T's own MarshalText when present and strconv otherwise.
+func (m Maybe[T]) MarshalText() ([]byte, error) {
+	if !m.valid {
+		return []byte{}, nil
+	}
+	return marshalText(m.value)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Empty text is decoded as None.
+func (m *Maybe[T]) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*m = None[T]()
+		return nil
+	}
+	var v T
+	if err := unmarshalText(text, &v); err != nil {
+		return err
+	}
+	*m = Some(v)
+	return nil
+}
+
+func (m MaybePrimitive[T]) MarshalText() ([]byte, error) {
+	if m.value == nil {
+		return []byte{}, nil
+	}
+	return marshalText(*m.value)
+}
+
+func (m *MaybePrimitive[T]) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*m = NonePrimitive[T]()
+		return nil
+	}
+	var v T
+	if err := unmarshalText(text, &v); err != nil {
+		return err
+	}
+	*m = SomePrimitive(v)
+	return nil
+}
+
+func marshalText(v any) ([]byte, error) {
+	switch x := v.(type) {
+	case encoding.TextMarshaler:
+		return x.MarshalText()
+	case time.Duration:
+		return []byte(x.String()), nil
+	}
+
+	rv := reflect.ValueOf(v)
+	if !rv.IsValid() {
+		return nil, fmt.Errorf("maybe: cannot marshal %T as text", v)
+	}
+	switch rv.Kind() {
+	case reflect.String:
+		return []byte(rv.String()), nil
+	case reflect.Bool:
+		return strconv.AppendBool(nil, rv.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.AppendInt(nil, rv.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.AppendUint(nil, rv.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.AppendFloat(nil, rv.Float(), 'g', -1, rv.Type().Bits()), nil
+	}
+	return nil, fmt.Errorf("maybe: cannot marshal %T as text", v)
+}
+
+func unmarshalText(text []byte, dst any) error {
+	switch x := dst.(type) {
+	case encoding.TextUnmarshaler:
+		return x.UnmarshalText(text)
+	case *time.Duration:
+		d, err := time.ParseDuration(string(text))
+		if err != nil {
+			return err
+		}
+		*x = d
+		return nil
+	}
+
+	rv := reflect.ValueOf(dst).Elem()
+	s := string(text)
+	switch rv.Kind() {
+	case reflect.String:
+		rv.SetString(s)
+		return nil
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		rv.SetBool(b)
+		return nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(s, 10, rv.Type().Bits())
+		if err != nil {
+			return err
+		}
+		rv.SetInt(n)
+		return nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		n, err := strconv.ParseUint(s, 10, rv.Type().Bits())
+		if err != nil {
+			return err
+		}
+		rv.SetUint(n)
+		return nil
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(s, rv.Type().Bits())
+		if err != nil {
+			return err
+		}
+		rv.SetFloat(f)
+		return nil
+	}
+	return fmt.Errorf("maybe: cannot unmarshal text into %s", rv.Type())
+}
@@ -0,0 +1,91 @@
+package maybe_test
+
+import (
+	"flag"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/magicdrive/maybe"
+)
+
+func TestMaybeTextRoundTrip(t *testing.T) {
+	d := maybe.Some(90 * time.Second)
+	b, err := d.MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != "1m30s" {
+		t.Errorf("expected 1m30s, got %s", b)
+	}
+
+	var out maybe.Maybe[time.Duration]
+	if err := out.UnmarshalText(b); err != nil {
+		t.Fatal(err)
+	}
+	if out.UnwrapOr(0) != 90*time.Second {
+		t.Errorf("expected 90s, got %v", out.UnwrapOr(0))
+	}
+
+	if err := out.UnmarshalText(nil); err != nil || out.IsSome() {
+		t.Errorf("expected empty text to decode as None")
+	}
+	b, _ = out.MarshalText()
+	if len(b) != 0 {
+		t.Errorf("expected None to encode as empty text, got %q", b)
+	}
+}
+
+func TestMaybeTextDelegatesToTextUnmarshaler(t *testing.T) {
+	var addr maybe.Maybe[netip.Addr]
+	if err := addr.UnmarshalText([]byte("127.0.0.1")); err != nil {
+		t.Fatal(err)
+	}
+	if !addr.Unwrap().IsLoopback() {
+		t.Errorf("expected loopback address, got %v", addr.Unwrap())
+	}
+	if err := addr.UnmarshalText([]byte("not-an-ip")); err == nil {
+		t.Errorf("expected parse error")
+	}
+}
+
+func TestMaybePrimitiveText(t *testing.T) {
+	var n maybe.MaybePrimitive[int]
+	if err := n.UnmarshalText([]byte("42")); err != nil {
+		t.Fatal(err)
+	}
+	if n.UnwrapOr(0) != 42 {
+		t.Errorf("expected 42, got %v", n.UnwrapOr(0))
+	}
+	if err := n.UnmarshalText([]byte("x")); err == nil {
+		t.Errorf("expected parse error")
+	}
+
+	var u maybe.MaybePrimitive[UserID]
+	if err := u.UnmarshalText([]byte("7")); err != nil || u.UnwrapOr(0) != 7 {
+		t.Errorf("expected UserID(7), got %v (%v)", u.UnwrapOr(0), err)
+	}
+
+	b, err := maybe.SomePrimitive(2.5).MarshalText()
+	if err != nil || string(b) != "2.5" {
+		t.Errorf("expected 2.5, got %s (%v)", b, err)
+	}
+}
+
+func TestMaybeTextWithFlagTextVar(t *testing.T) {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	var timeout maybe.Maybe[time.Duration]
+	var retries maybe.MaybePrimitive[int]
+	fs.TextVar(&timeout, "timeout", maybe.None[time.Duration](), "timeout")
+	fs.TextVar(&retries, "retries", maybe.NonePrimitive[int](), "retries")
+
+	if err := fs.Parse([]string{"-timeout", "5s"}); err != nil {
+		t.Fatal(err)
+	}
+	if timeout.UnwrapOr(0) != 5*time.Second {
+		t.Errorf("expected 5s, got %v", timeout.UnwrapOr(0))
+	}
+	if retries.IsSome() {
+		t.Errorf("expected retries to stay None")
+	}
+}
@@ -0,0 +1,14 @@
+package maybe
+
+// Traverse applies f to every element of xs and returns None at the first None.
+func Traverse[A any, B any](xs []A, f func(A) Maybe[B]) Maybe[[]B] {
+	values := make([]B, 0, len(xs))
+	for _, x := range xs {
+		m := f(x)
+		if !m.valid {
+			return None[[]B]()
+		}
+		values = append(values, m.value)
+	}
+	return Some(values)
+}
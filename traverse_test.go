@@ -0,0 +1,29 @@
+package maybe_test
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/magicdrive/maybe"
+)
+
+func TestTraverse(t *testing.T) {
+	parse := func(s string) maybe.Maybe[int] {
+		return maybe.Try(func() (int, error) { return strconv.Atoi(s) })
+	}
+
+	got := maybe.Traverse([]string{"1", "2"}, parse)
+	if !reflect.DeepEqual(got.UnwrapOr(nil), []int{1, 2}) {
+		t.Errorf("expected Some([1 2]), got %v", got)
+	}
+
+	calls := 0
+	failed := maybe.Traverse([]string{"1", "x", "3"}, func(s string) maybe.Maybe[int] {
+		calls++
+		return parse(s)
+	})
+	if failed.IsSome() || calls != 2 {
+		t.Errorf("expected None after 2 calls, got %v after %d", failed, calls)
+	}
+}
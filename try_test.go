@@ -113,3 +113,36 @@ func TestFoldPrimitive(t *testing.T) {
 		t.Errorf("expected 'none', got %s", result2)
 	}
 }
+
+func TestTryRecover(t *testing.T) {
+	ok := maybe.TryRecover(func() (int, error) { return 1, nil })
+	if ok.UnwrapOr(0) != 1 {
+		t.Errorf("expected Some(1)")
+	}
+
+	panicked := maybe.TryRecover(func() (int, error) { panic("boom") })
+	if panicked.IsSome() {
+		t.Errorf("expected None after panic")
+	}
+
+	failed := maybe.TryRecover(func() (int, error) { return 0, errors.New("fail") })
+	if failed.IsSome() {
+		t.Errorf("expected None after error")
+	}
+}
+
+func TestUnwrapPanicsWithErrNone(t *testing.T) {
+	for name, unwrap := range map[string]func(){
+		"Maybe":          func() { maybe.None[int]().Unwrap() },
+		"MaybePrimitive": func() { maybe.NonePrimitive[int]().Unwrap() },
+	} {
+		func() {
+			defer func() {
+				if v := recover(); v != maybe.ErrNone {
+					t.Errorf("%s: expected ErrNone panic, got %v", name, v)
+				}
+			}()
+			unwrap()
+		}()
+	}
+}
@@ -0,0 +1,23 @@
+package maybe
+
+import "github.com/magicdrive/maybe/tuple"
+
+func FromValue2[A any, B any](a A, b B, ok bool) Maybe[tuple.Pair[A, B]] {
+	return FromValue(tuple.NewPair(a, b), ok)
+}
+
+func Try2[A any, B any](f func() (A, B, error)) Maybe[tuple.Pair[A, B]] {
+	a, b, err := f()
+	if err != nil {
+		return None[tuple.Pair[A, B]]()
+	}
+	return Some(tuple.NewPair(a, b))
+}
+
+func Try3[A any, B any, C any](f func() (A, B, C, error)) Maybe[tuple.Triple[A, B, C]] {
+	a, b, c, err := f()
+	if err != nil {
+		return None[tuple.Triple[A, B, C]]()
+	}
+	return Some(tuple.NewTriple(a, b, c))
+}
@@ -0,0 +1,28 @@
+package tuple
+
+type Pair[A any, B any] struct {
+	First  A
+	Second B
+}
+
+func NewPair[A any, B any](a A, b B) Pair[A, B] {
+	return Pair[A, B]{First: a, Second: b}
+}
+
+func (p Pair[A, B]) Unpack() (A, B) {
+	return p.First, p.Second
+}
+
+type Triple[A any, B any, C any] struct {
+	First  A
+	Second B
+	Third  C
+}
+
+func NewTriple[A any, B any, C any](a A, b B, c C) Triple[A, B, C] {
+	return Triple[A, B, C]{First: a, Second: b, Third: c}
+}
+
+func (t Triple[A, B, C]) Unpack() (A, B, C) {
+	return t.First, t.Second, t.Third
+}
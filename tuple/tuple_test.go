@@ -0,0 +1,21 @@
+package tuple_test
+
+import (
+	"testing"
+
+	"github.com/magicdrive/maybe/tuple"
+)
+
+func TestPair(t *testing.T) {
+	a, b := tuple.NewPair(1, "x").Unpack()
+	if a != 1 || b != "x" {
+		t.Errorf("unexpected unpack: %v %v", a, b)
+	}
+}
+
+func TestTriple(t *testing.T) {
+	a, b, c := tuple.NewTriple(1, "x", true).Unpack()
+	if a != 1 || b != "x" || !c {
+		t.Errorf("unexpected unpack: %v %v %v", a, b, c)
+	}
+}
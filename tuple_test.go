@@ -0,0 +1,54 @@
+package maybe_test
+
+import (
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/magicdrive/maybe"
+	"github.com/magicdrive/maybe/tuple"
+)
+
+func TestTry2AndTry3(t *testing.T) {
+	hp := maybe.Try2(func() (string, string, error) {
+		return net.SplitHostPort("example.com:80")
+	})
+	if hp.Unwrap() != tuple.NewPair("example.com", "80") {
+		t.Errorf("unexpected pair: %v", hp)
+	}
+	if maybe.Try2(func() (string, string, error) { return net.SplitHostPort("nope") }).IsSome() {
+		t.Errorf("expected None on error")
+	}
+
+	tr := maybe.Try3(func() (int, string, bool, error) { return 1, "a", true, nil })
+	if tr.Unwrap() != tuple.NewTriple(1, "a", true) {
+		t.Errorf("unexpected triple: %v", tr)
+	}
+	if maybe.Try3(func() (int, int, int, error) { return 0, 0, 0, errors.New("x") }).IsSome() {
+		t.Errorf("expected None on error")
+	}
+}
+
+func TestFromValue2(t *testing.T) {
+	if maybe.FromValue2(1, "a", true).Unwrap() != tuple.NewPair(1, "a") {
+		t.Errorf("expected Some((1, a))")
+	}
+	if maybe.FromValue2(1, "a", false).IsSome() {
+		t.Errorf("expected None")
+	}
+}
+
+func TestMaybeGet(t *testing.T) {
+	if v, ok := maybe.Some(3).Get(); !ok || v != 3 {
+		t.Errorf("expected (3, true), got (%v, %v)", v, ok)
+	}
+	if v, ok := maybe.None[int]().Get(); ok || v != 0 {
+		t.Errorf("expected (0, false), got (%v, %v)", v, ok)
+	}
+	if v, ok := maybe.SomePrimitive("x").Get(); !ok || v != "x" {
+		t.Errorf("expected (x, true), got (%v, %v)", v, ok)
+	}
+	if _, ok := maybe.NonePrimitive[bool]().Get(); ok {
+		t.Errorf("expected false for NonePrimitive")
+	}
+}
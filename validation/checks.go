@@ -0,0 +1,47 @@
+package validation
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"regexp"
+
+	"github.com/magicdrive/maybe"
+)
+
+// ErrRequired is reported by Required for a None field.
+var ErrRequired = errors.New("is required")
+
+func Required[T any](field string, m maybe.Maybe[T]) Validation[T] {
+	return FromMaybe(m, field, ErrRequired)
+}
+
+func Min[T cmp.Ordered](field string, v T, lo T) Validation[T] {
+	if v < lo {
+		return Failf[T](field, "must be at least %v", lo)
+	}
+	return Valid(v)
+}
+
+func Max[T cmp.Ordered](field string, v T, hi T) Validation[T] {
+	if v > hi {
+		return Failf[T](field, "must be at most %v", hi)
+	}
+	return Valid(v)
+}
+
+func Regex(field string, s string, re *regexp.Regexp) Validation[string] {
+	if !re.MatchString(s) {
+		return Failf[string](field, "must match %s", re)
+	}
+	return Valid(s)
+}
+
+func OneOf[T comparable](field string, v T, options ...T) Validation[T] {
+	for _, o := range options {
+		if v == o {
+			return Valid(v)
+		}
+	}
+	return Fail[T](field, fmt.Errorf("must be one of %v", options))
+}
@@ -0,0 +1,52 @@
+package validation
+
+// Map2 combines two validations with fn. When any is invalid, the errors of
+// all of them are merged in argument order and fn is not called.
+func Map2[A any, B any, R any](a Validation[A], b Validation[B], fn func(A, B) R) Validation[R] {
+	if errs := mergeErrors(a.errs, b.errs); len(errs) > 0 {
+		return Validation[R]{errs: errs}
+	}
+	return Valid(fn(a.value, b.value))
+}
+
+func Map3[A any, B any, C any, R any](a Validation[A], b Validation[B], c Validation[C], fn func(A, B, C) R) Validation[R] {
+	if errs := mergeErrors(a.errs, b.errs, c.errs); len(errs) > 0 {
+		return Validation[R]{errs: errs}
+	}
+	return Valid(fn(a.value, b.value, c.value))
+}
+
+func Map4[A any, B any, C any, D any, R any](a Validation[A], b Validation[B], c Validation[C], d Validation[D], fn func(A, B, C, D) R) Validation[R] {
+	if errs := mergeErrors(a.errs, b.errs, c.errs, d.errs); len(errs) > 0 {
+		return Validation[R]{errs: errs}
+	}
+	return Valid(fn(a.value, b.value, c.value, d.value))
+}
+
+func Map5[A any, B any, C any, D any, E any, R any](a Validation[A], b Validation[B], c Validation[C], d Validation[D], e Validation[E], fn func(A, B, C, D, E) R) Validation[R] {
+	if errs := mergeErrors(a.errs, b.errs, c.errs, d.errs, e.errs); len(errs) > 0 {
+		return Validation[R]{errs: errs}
+	}
+	return Valid(fn(a.value, b.value, c.value, d.value, e.value))
+}
+
+func Map6[A any, B any, C any, D any, E any, F any, R any](a Validation[A], b Validation[B], c Validation[C], d Validation[D], e Validation[E], f Validation[F], fn func(A, B, C, D, E, F) R) Validation[R] {
+	if errs := mergeErrors(a.errs, b.errs, c.errs, d.errs, e.errs, f.errs); len(errs) > 0 {
+		return Validation[R]{errs: errs}
+	}
+	return Valid(fn(a.value, b.value, c.value, d.value, e.value, f.value))
+}
+
+func Map7[A any, B any, C any, D any, E any, F any, G any, R any](a Validation[A], b Validation[B], c Validation[C], d Validation[D], e Validation[E], f Validation[F], g Validation[G], fn func(A, B, C, D, E, F, G) R) Validation[R] {
+	if errs := mergeErrors(a.errs, b.errs, c.errs, d.errs, e.errs, f.errs, g.errs); len(errs) > 0 {
+		return Validation[R]{errs: errs}
+	}
+	return Valid(fn(a.value, b.value, c.value, d.value, e.value, f.value, g.value))
+}
+
+func Map8[A any, B any, C any, D any, E any, F any, G any, H any, R any](a Validation[A], b Validation[B], c Validation[C], d Validation[D], e Validation[E], f Validation[F], g Validation[G], h Validation[H], fn func(A, B, C, D, E, F, G, H) R) Validation[R] {
+	if errs := mergeErrors(a.errs, b.errs, c.errs, d.errs, e.errs, f.errs, g.errs, h.errs); len(errs) > 0 {
+		return Validation[R]{errs: errs}
+	}
+	return Valid(fn(a.value, b.value, c.value, d.value, e.value, f.value, g.value, h.value))
+}
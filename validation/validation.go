@@ -0,0 +1,185 @@
+package validation
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/magicdrive/maybe"
+	"github.com/magicdrive/maybe/result"
+)
+
+// FieldError is a validation failure tagged with the field it belongs to.
+type FieldError struct {
+	Field string
+	Err   error
+}
+
+func (e FieldError) Error() string {
+	if e.Field == "" {
+		return e.message()
+	}
+	return e.Field + ": " + e.message()
+}
+
+// message is the text of Err, or "is invalid" when a failure was recorded
+// without one.
+func (e FieldError) message() string {
+	if e.Err == nil {
+		return "is invalid"
+	}
+	return e.Err.Error()
+}
+
+func (e FieldError) Unwrap() error {
+	return e.Err
+}
+
+func (e FieldError) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Field   string `json:"field"`
+		Message string `json:"message"`
+	}{e.Field, e.message()})
+}
+
+// Errors is the non-empty list of failures of an invalid Validation. It
+// renders as a multi-line report and marshals to a JSON array.
+type Errors []FieldError
+
+func (es Errors) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "validation failed with %d error(s):", len(es))
+	for _, e := range es {
+		b.WriteString("\n  - ")
+		b.WriteString(e.Error())
+	}
+	return b.String()
+}
+
+func (es Errors) Unwrap() []error {
+	errs := make([]error, len(es))
+	for i, e := range es {
+		errs[i] = e
+	}
+	return errs
+}
+
+// Validation holds either a value or every error found while building it.
+type Validation[T any] struct {
+	value T
+	errs  Errors
+}
+
+func Valid[T any](v T) Validation[T] {
+	return Validation[T]{value: v}
+}
+
+// Invalid panics when errs is empty, since an invalid Validation must
+// carry at least one error.
+func Invalid[T any](errs ...FieldError) Validation[T] {
+	if len(errs) == 0 {
+		panic("validation: Invalid called without errors")
+	}
+	return Validation[T]{errs: append(Errors(nil), errs...)}
+}
+
+func Fail[T any](field string, err error) Validation[T] {
+	return Invalid[T](FieldError{Field: field, Err: err})
+}
+
+func Failf[T any](field string, format string, args ...any) Validation[T] {
+	return Fail[T](field, fmt.Errorf(format, args...))
+}
+
+func (v Validation[T]) IsValid() bool {
+	return len(v.errs) == 0
+}
+
+func (v Validation[T]) IsInvalid() bool {
+	return len(v.errs) > 0
+}
+
+// Errors returns the collected errors, or nil when v is valid.
+func (v Validation[T]) Errors() Errors {
+	return v.errs
+}
+
+func (v Validation[T]) Unwrap() T {
+	if len(v.errs) > 0 {
+		panic(fmt.Errorf("called Unwrap on invalid Validation: %w", v.errs))
+	}
+	return v.value
+}
+
+func (v Validation[T]) UnwrapOr(def T) T {
+	if len(v.errs) > 0 {
+		return def
+	}
+	return v.value
+}
+
+func (v Validation[T]) ToResult() result.Result[T, Errors] {
+	if len(v.errs) > 0 {
+		return result.Err[T](v.errs)
+	}
+	return result.Ok[T, Errors](v.value)
+}
+
+func (v Validation[T]) ToMaybe() maybe.Maybe[T] {
+	return maybe.FromValue(v.value, len(v.errs) == 0)
+}
+
+// FromResult tags the error of an Err with field. An Errors value is kept
+// as is so that validations can round-trip through Result.
+func FromResult[T any, E error](r result.Result[T, E], field string) Validation[T] {
+	if r.IsOk() {
+		return Valid(r.Unwrap())
+	}
+	var err error = r.UnwrapErr()
+	if es, ok := err.(Errors); ok && len(es) > 0 {
+		return Invalid[T](es...)
+	}
+	return Fail[T](field, err)
+}
+
+// FromMaybe fails with err tagged by field when m is None.
+func FromMaybe[T any](m maybe.Maybe[T], field string, err error) Validation[T] {
+	if m.IsNone() {
+		return Fail[T](field, err)
+	}
+	return Valid(m.Unwrap())
+}
+
+func Map[T any, U any](v Validation[T], f func(T) U) Validation[U] {
+	if len(v.errs) > 0 {
+		return Validation[U]{errs: v.errs}
+	}
+	return Valid(f(v.value))
+}
+
+// AndThen runs f only when v is valid. Unlike the MapN functions it cannot
+// accumulate errors, since f needs the value.
+func AndThen[T any, U any](v Validation[T], f func(T) Validation[U]) Validation[U] {
+	if len(v.errs) > 0 {
+		return Validation[U]{errs: v.errs}
+	}
+	return f(v.value)
+}
+
+// All merges several validations of the same value, such as Min and Max on
+// one field. The value is taken from the first validation.
+func All[T any](first Validation[T], rest ...Validation[T]) Validation[T] {
+	errs := first.errs
+	for _, v := range rest {
+		errs = mergeErrors(errs, v.errs)
+	}
+	return Validation[T]{value: first.value, errs: errs}
+}
+
+func mergeErrors(lists ...Errors) Errors {
+	var merged Errors
+	for _, l := range lists {
+		merged = append(merged, l...)
+	}
+	return merged
+}
@@ -0,0 +1,178 @@
+package validation_test
+
+import (
+	"encoding/json"
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/magicdrive/maybe"
+	"github.com/magicdrive/maybe/result"
+	"github.com/magicdrive/maybe/validation"
+)
+
+type signupForm struct {
+	Name  maybe.Maybe[string]
+	Email string
+	Age   int
+	Plan  string
+}
+
+type account struct {
+	Name  string
+	Email string
+	Age   int
+	Plan  string
+}
+
+var emailPattern = regexp.MustCompile(`^[^@\s]+@[^@\s]+$`)
+
+func validateSignup(f signupForm) validation.Validation[account] {
+	return validation.Map4(
+		validation.Required("name", f.Name),
+		validation.Regex("email", f.Email, emailPattern),
+		validation.All(validation.Min("age", f.Age, 18), validation.Max("age", f.Age, 120)),
+		validation.OneOf("plan", f.Plan, "free", "pro"),
+		func(name, email string, age int, plan string) account {
+			return account{Name: name, Email: email, Age: age, Plan: plan}
+		},
+	)
+}
+
+func TestValidationAccumulatesErrors(t *testing.T) {
+	v := validateSignup(signupForm{Email: "nope", Age: 10, Plan: "gold"})
+	if v.IsValid() {
+		t.Fatal("expected invalid")
+	}
+
+	errs := v.Errors()
+	fields := make([]string, len(errs))
+	for i, e := range errs {
+		fields[i] = e.Field
+	}
+	if len(errs) != 4 || fields[0] != "name" || fields[1] != "email" || fields[2] != "age" || fields[3] != "plan" {
+		t.Errorf("expected errors for every field in order, got %v", fields)
+	}
+	if !errors.Is(errs, validation.ErrRequired) {
+		t.Errorf("expected errors.Is to find ErrRequired")
+	}
+
+	want := "validation failed with 4 error(s):\n" +
+		"  - name: is required\n" +
+		"  - email: must match ^[^@\\s]+@[^@\\s]+$\n" +
+		"  - age: must be at least 18\n" +
+		"  - plan: must be one of [free pro]"
+	if errs.Error() != want {
+		t.Errorf("unexpected report:\n%s", errs.Error())
+	}
+}
+
+func TestValidationSuccess(t *testing.T) {
+	v := validateSignup(signupForm{Name: maybe.Some("taro"), Email: "t@example.com", Age: 30, Plan: "pro"})
+	if !v.IsValid() || v.Errors() != nil {
+		t.Fatalf("expected valid, got %v", v.Errors())
+	}
+	if got := v.Unwrap(); got.Name != "taro" || got.Plan != "pro" {
+		t.Errorf("unexpected value: %+v", got)
+	}
+}
+
+func TestErrorsJSON(t *testing.T) {
+	v := validation.Map2(
+		validation.Fail[int]("a", errors.New("bad a")),
+		validation.Max("b", 5, 3),
+		func(a, b int) int { return a + b },
+	)
+	b, err := json.Marshal(v.Errors())
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `[{"field":"a","message":"bad a"},{"field":"b","message":"must be at most 3"}]`
+	if string(b) != want {
+		t.Errorf("expected %s, got %s", want, b)
+	}
+}
+
+func TestMapN(t *testing.T) {
+	ok := validation.Valid[int]
+	sum := validation.Map8(ok(1), ok(2), ok(3), ok(4), ok(5), ok(6), ok(7), ok(8),
+		func(a, b, c, d, e, f, g, h int) int { return a + b + c + d + e + f + g + h })
+	if sum.Unwrap() != 36 {
+		t.Errorf("expected 36, got %v", sum.Unwrap())
+	}
+
+	bad := validation.Map3(ok(1), validation.Failf[int]("x", "bad"), validation.Failf[int]("y", "bad"),
+		func(a, b, c int) int { return a })
+	if len(bad.Errors()) != 2 {
+		t.Errorf("expected 2 errors, got %v", bad.Errors())
+	}
+}
+
+func TestConversions(t *testing.T) {
+	r := validation.Fail[int]("f", errors.New("x")).ToResult()
+	if r.IsOk() || len(r.UnwrapErr()) != 1 {
+		t.Errorf("expected Err with 1 error")
+	}
+	if validation.FromResult(r, "ignored").Errors()[0].Field != "f" {
+		t.Errorf("expected Errors to round-trip through Result")
+	}
+
+	fromErr := validation.FromResult(result.Err[int](errors.New("db down")), "user")
+	if e := fromErr.Errors(); len(e) != 1 || e[0].Error() != "user: db down" {
+		t.Errorf("unexpected errors: %v", e)
+	}
+	if validation.FromResult(result.Ok[int, error](3), "n").Unwrap() != 3 {
+		t.Errorf("expected Valid(3)")
+	}
+
+	if validation.Valid("x").ToMaybe().UnwrapOr("") != "x" {
+		t.Errorf("expected Some(x)")
+	}
+	if validation.Fail[string]("f", errors.New("x")).ToMaybe().IsSome() {
+		t.Errorf("expected None")
+	}
+	if validation.FromMaybe(maybe.None[int](), "n", validation.ErrRequired).IsValid() {
+		t.Errorf("expected invalid")
+	}
+}
+
+func TestMapAndThen(t *testing.T) {
+	v := validation.Map(validation.Valid(2), func(x int) int { return x * 2 })
+	if v.Unwrap() != 4 {
+		t.Errorf("expected 4")
+	}
+	called := false
+	chained := validation.AndThen(validation.Failf[int]("a", "bad"), func(x int) validation.Validation[int] {
+		called = true
+		return validation.Valid(x)
+	})
+	if chained.IsValid() || called {
+		t.Errorf("expected AndThen to skip f on invalid input")
+	}
+}
+
+func TestInvalidRequiresErrors(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("expected panic")
+		}
+	}()
+	validation.Invalid[int]()
+}
+
+func TestFailWithNilError(t *testing.T) {
+	v := validation.Fail[int]("a", nil)
+	if v.IsValid() {
+		t.Fatalf("expected Fail with a nil error to stay invalid")
+	}
+	if got := v.Errors()[0].Error(); got != "a: is invalid" {
+		t.Errorf("unexpected message: %s", got)
+	}
+	b, err := json.Marshal(v.Errors())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `[{"field":"a","message":"is invalid"}]`; string(b) != want {
+		t.Errorf("expected %s, got %s", want, b)
+	}
+}
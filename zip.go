@@ -0,0 +1,66 @@
+package maybe
+
+import "github.com/magicdrive/maybe/tuple"
+
+func Zip[A any, B any](a Maybe[A], b Maybe[B]) Maybe[tuple.Pair[A, B]] {
+	return ZipWith(a, b, tuple.NewPair[A, B])
+}
+
+func Zip3[A any, B any, C any](a Maybe[A], b Maybe[B], c Maybe[C]) Maybe[tuple.Triple[A, B, C]] {
+	return Lift3(tuple.NewTriple[A, B, C])(a, b, c)
+}
+
+func Unzip[A any, B any](m Maybe[tuple.Pair[A, B]]) (Maybe[A], Maybe[B]) {
+	if !m.valid {
+		return None[A](), None[B]()
+	}
+	return Some(m.value.First), Some(m.value.Second)
+}
+
+func ZipWith[A any, B any, R any](a Maybe[A], b Maybe[B], f func(A, B) R) Maybe[R] {
+	if !a.valid || !b.valid {
+		return None[R]()
+	}
+	return Some(f(a.value, b.value))
+}
+
+// Apply calls the function in mf with the value in ma when both are Some.
+func Apply[A any, B any](mf Maybe[func(A) B], ma Maybe[A]) Maybe[B] {
+	if !mf.valid || !ma.valid {
+		return None[B]()
+	}
+	return Some(mf.value(ma.value))
+}
+
+func Lift2[A any, B any, R any](f func(A, B) R) func(Maybe[A], Maybe[B]) Maybe[R] {
+	return func(a Maybe[A], b Maybe[B]) Maybe[R] {
+		return ZipWith(a, b, f)
+	}
+}
+
+func Lift3[A any, B any, C any, R any](f func(A, B, C) R) func(Maybe[A], Maybe[B], Maybe[C]) Maybe[R] {
+	return func(a Maybe[A], b Maybe[B], c Maybe[C]) Maybe[R] {
+		if !a.valid || !b.valid || !c.valid {
+			return None[R]()
+		}
+		return Some(f(a.value, b.value, c.value))
+	}
+}
+
+func Lift4[A any, B any, C any, D any, R any](f func(A, B, C, D) R) func(Maybe[A], Maybe[B], Maybe[C], Maybe[D]) Maybe[R] {
+	return func(a Maybe[A], b Maybe[B], c Maybe[C], d Maybe[D]) Maybe[R] {
+		if !a.valid || !b.valid || !c.valid || !d.valid {
+			return None[R]()
+		}
+		return Some(f(a.value, b.value, c.value, d.value))
+	}
+}
+
+func Lift5[A any, B any, C any, D any, E any, R any](f func(A, B, C, D, E) R) func(Maybe[A], Maybe[B], Maybe[C], Maybe[D], Maybe[E]) Maybe[R] {
+	return func(a Maybe[A], b Maybe[B], c Maybe[C], d Maybe[D], e Maybe[E]) Maybe[R] {
+		if !a.valid || !b.valid || !c.valid || !d.valid || !e.valid {
+			return None[R]()
+		}
+		return Some(f(a.value, b.value, c.value, d.value, e.value))
+	}
+}
@@ -0,0 +1,80 @@
+package maybe_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/magicdrive/maybe"
+	"github.com/magicdrive/maybe/tuple"
+)
+
+func TestZipUnzip(t *testing.T) {
+	z := maybe.Zip(maybe.Some(1), maybe.Some("a"))
+	if z.Unwrap() != tuple.NewPair(1, "a") {
+		t.Errorf("expected Some((1, a)), got %v", z)
+	}
+	if maybe.Zip(maybe.Some(1), maybe.None[string]()).IsSome() {
+		t.Errorf("expected None when either side is None")
+	}
+
+	a, b := maybe.Unzip(z)
+	if a.Unwrap() != 1 || b.Unwrap() != "a" {
+		t.Errorf("unexpected unzip: %v %v", a, b)
+	}
+	na, nb := maybe.Unzip(maybe.None[tuple.Pair[int, string]]())
+	if na.IsSome() || nb.IsSome() {
+		t.Errorf("expected None, None")
+	}
+
+	z3 := maybe.Zip3(maybe.Some(1), maybe.Some("a"), maybe.Some(true))
+	if z3.Unwrap() != tuple.NewTriple(1, "a", true) {
+		t.Errorf("unexpected Zip3: %v", z3)
+	}
+}
+
+func TestZipWithAndApply(t *testing.T) {
+	sum := maybe.ZipWith(maybe.Some(2), maybe.Some(3), func(a, b int) int { return a + b })
+	if sum.UnwrapOr(0) != 5 {
+		t.Errorf("expected Some(5), got %v", sum)
+	}
+
+	double := maybe.Some(func(x int) int { return x * 2 })
+	if maybe.Apply(double, maybe.Some(4)).UnwrapOr(0) != 8 {
+		t.Errorf("expected Some(8)")
+	}
+	if maybe.Apply(maybe.None[func(int) int](), maybe.Some(4)).IsSome() {
+		t.Errorf("expected None for None function")
+	}
+}
+
+func TestLift(t *testing.T) {
+	join := maybe.Lift3(func(a string, b int, c bool) string {
+		return fmt.Sprint(a, b, c)
+	})
+	if join(maybe.Some("x"), maybe.Some(1), maybe.Some(true)).UnwrapOr("") != "x1 true" {
+		t.Errorf("unexpected Lift3 result")
+	}
+	if join(maybe.Some("x"), maybe.None[int](), maybe.Some(true)).IsSome() {
+		t.Errorf("expected None")
+	}
+
+	concat4 := maybe.Lift4(func(a, b, c, d string) string { return a + b + c + d })
+	if concat4(maybe.Some("a"), maybe.Some("b"), maybe.Some("c"), maybe.Some("d")).Unwrap() != "abcd" {
+		t.Errorf("unexpected Lift4 result")
+	}
+
+	concat5 := maybe.Lift5(func(a, b, c, d, e string) string { return strings.Join([]string{a, b, c, d, e}, "") })
+	s := maybe.Some[string]
+	if concat5(s("a"), s("b"), s("c"), s("d"), s("e")).Unwrap() != "abcde" {
+		t.Errorf("unexpected Lift5 result")
+	}
+	if concat5(s("a"), s("b"), s("c"), s("d"), maybe.None[string]()).IsSome() {
+		t.Errorf("expected None")
+	}
+
+	add := maybe.Lift2(func(a, b int) int { return a + b })
+	if add(maybe.Some(1), maybe.Some(2)).Unwrap() != 3 {
+		t.Errorf("unexpected Lift2 result")
+	}
+}